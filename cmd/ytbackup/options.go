@@ -4,15 +4,28 @@ import (
 	"mkuznets.com/go/ytbackup/internal/ytbackup"
 	"mkuznets.com/go/ytbackup/internal/ytbackup/check"
 	"mkuznets.com/go/ytbackup/internal/ytbackup/start"
+	"mkuznets.com/go/ytbackup/internal/ytbackup/storage"
 )
 
 type Options struct {
-	Common  *ytbackup.Options        `group:"Common Options"`
-	Start   *start.Command           `command:"start" description:"Start pulling sources and downloading videos"`
-	Setup   *ytbackup.SetupCommand   `command:"setup" description:"Configure OAuth token for Youtube API"`
-	Import  *ytbackup.ImportCommand  `command:"import" description:"Import videos from Google's takeout JSON files"`
-	List    *ytbackup.ListCommand    `command:"list" description:"List videos"`
-	Check   *check.Command           `command:"check" description:"Data integrity checks"`
-	Add     *ytbackup.AddCommand     `command:"add"  description:"Add one or more videos by ID"`
-	Version *ytbackup.VersionCommand `command:"version" description:"Show version"`
+	Common    *ytbackup.Options          `group:"Common Options"`
+	Start     *start.Command             `command:"start" description:"Start pulling sources and downloading videos"`
+	Setup     *ytbackup.SetupCommand     `command:"setup" description:"Configure OAuth token for Youtube API"`
+	Import    *ytbackup.ImportCommand    `command:"import" description:"Import videos from Google's takeout JSON files"`
+	List      *ytbackup.ListCommand      `command:"list" description:"List videos"`
+	Show      *ytbackup.ShowCommand      `command:"show" description:"Show details of a video"`
+	Check     *check.Command             `command:"check" description:"Data integrity checks"`
+	Add       *ytbackup.AddCommand       `command:"add"  description:"Add one or more videos by ID"`
+	Sync      *ytbackup.SyncCommand      `command:"sync" description:"Show playlist checkpoints or request a full scan"`
+	Stats     *ytbackup.StatsCommand     `command:"stats" description:"Show index and API quota statistics"`
+	Scheduled *ytbackup.ScheduledCommand `command:"scheduled" description:"List upcoming premieres and live streams waiting to be downloaded"`
+	Channels  *ytbackup.ChannelsCommand  `command:"channels" description:"List channels of downloaded videos and their archives"`
+	Storages  *ytbackup.StoragesCommand  `command:"storages" description:"Show known storage volumes"`
+	Storage   *storage.Command           `command:"storage" description:"Move videos between storages"`
+	M3U       *ytbackup.M3UCommand       `command:"m3u" description:"Export source playlists as M3U files with local paths"`
+	Relayout  *ytbackup.RelayoutCommand  `command:"relayout" description:"Move downloaded files to paths of a new layout"`
+	Sidecars  *ytbackup.SidecarsCommand  `command:"sidecars" description:"Write .nfo files and posters for media servers"`
+	Backfill  *ytbackup.BackfillCommand  `command:"backfill" description:"Fill missing metadata of downloaded videos from their info.json files"`
+	Serve     *ytbackup.ServeCommand     `command:"serve" description:"Serve web UI and read-only HTTP API"`
+	Version   *ytbackup.VersionCommand   `command:"version" description:"Show version"`
 }
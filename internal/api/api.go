@@ -0,0 +1,248 @@
+// Package api implements a read-only HTTP/JSON API over the index.
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/control"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/storages"
+)
+
+const (
+	defaultLimit        = 100
+	maxLimit            = 1000
+	storageCacheTimeout = time.Minute
+)
+
+var contentTypes = map[string]string{
+	".mkv":  "video/x-matroska",
+	".webm": "video/webm",
+	".mp4":  "video/mp4",
+	".m4a":  "audio/mp4",
+	".opus": "audio/ogg; codecs=opus",
+	".ogg":  "audio/ogg",
+	".mp3":  "audio/mpeg",
+	".aac":  "audio/aac",
+	".flac": "audio/flac",
+	".vtt":  "text/vtt; charset=utf-8",
+	".json": "application/json; charset=utf-8",
+}
+
+type API struct {
+	index    control.Index
+	storages *storages.Storages
+	mux      *http.ServeMux
+
+	pathsLock    sync.Mutex
+	paths        map[string]string
+	pathsUpdated time.Time
+}
+
+func New(idx control.Index, sts *storages.Storages) *API {
+	api := &API{index: idx, storages: sts, mux: http.NewServeMux()}
+
+	api.mux.HandleFunc("/api/videos", api.listVideos)
+	api.mux.HandleFunc("/api/videos/", api.getVideo)
+	api.mux.HandleFunc("/api/stats", api.stats)
+	api.mux.HandleFunc("/api/storages", api.listStorages)
+	api.mux.HandleFunc("/api/rescued", api.listRescued)
+	api.mux.HandleFunc("/api/rescued.atom", api.rescuedFeed)
+
+	return api
+}
+
+func (api *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	api.mux.ServeHTTP(w, r)
+}
+
+type videosPage struct {
+	Videos []*index.Video `json:"videos"`
+	Next   string         `json:"next,omitempty"`
+}
+
+// GET /api/videos?status=DONE&limit=100&cursor=...
+func (api *API) listVideos(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	limit := defaultLimit
+	if v := q.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxLimit {
+			writeError(w, http.StatusBadRequest, "limit must be between 1 and 1000")
+			return
+		}
+		limit = n
+	}
+
+	status := index.Status(strings.ToUpper(q.Get("status")))
+
+	videos, next, err := api.index.Page(status, q.Get("cursor"), limit)
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &videosPage{Videos: videos, Next: next})
+}
+
+// GET /api/videos/{id}
+// GET /api/videos/{id}/files/{path}
+func (api *API) getVideo(w http.ResponseWriter, r *http.Request) {
+	ps := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/api/videos/"), "/", 3)
+	id := ps[0]
+
+	switch {
+	case id == "":
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	case len(ps) == 3 && ps[1] == "files":
+		api.getFile(w, r, id, ps[2])
+		return
+	case len(ps) > 1:
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	video, err := api.index.Find(id)
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	if video == nil {
+		writeError(w, http.StatusNotFound, "video not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, video)
+}
+
+// getFile streams one of the video files, supporting range requests.
+func (api *API) getFile(w http.ResponseWriter, r *http.Request, id, path string) {
+	video, err := api.index.Find(id)
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	if video == nil || video.Status != index.StatusDone {
+		writeError(w, http.StatusNotFound, "video not found")
+		return
+	}
+
+	known := false
+	for _, f := range video.Files {
+		if f.Path == path {
+			known = true
+			break
+		}
+	}
+	if !known {
+		writeError(w, http.StatusNotFound, "file not found")
+		return
+	}
+
+	paths := api.storagePaths()
+
+	for _, st := range video.Storages {
+		root, ok := paths[st.ID]
+		if !ok {
+			continue
+		}
+
+		f, err := os.Open(filepath.Join(root, filepath.FromSlash(path)))
+		if err != nil {
+			log.Debug().Err(err).Str("id", id).Str("path", path).Msg("Could not open file")
+			continue
+		}
+		defer f.Close() // nolint
+
+		fi, err := f.Stat()
+		if err != nil {
+			writeInternalError(w, err)
+			return
+		}
+
+		if ct, ok := contentTypes[strings.ToLower(filepath.Ext(path))]; ok {
+			w.Header().Set("Content-Type", ct)
+		}
+		http.ServeContent(w, r, fi.Name(), fi.ModTime(), f)
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "storage is not available")
+}
+
+// storagePaths returns a recently cached mapping of storage IDs to their paths.
+func (api *API) storagePaths() map[string]string {
+	api.pathsLock.Lock()
+	defer api.pathsLock.Unlock()
+
+	if api.paths != nil && time.Since(api.pathsUpdated) < storageCacheTimeout {
+		return api.paths
+	}
+
+	paths := make(map[string]string)
+	for _, st := range api.storages.List() {
+		paths[st.ID] = st.Path
+	}
+	api.paths = paths
+	api.pathsUpdated = time.Now()
+
+	return paths
+}
+
+// GET /api/stats
+func (api *API) stats(w http.ResponseWriter, r *http.Request) {
+	counts, err := api.index.Count()
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+
+	total := 0
+	for _, n := range counts {
+		total += n
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"statuses": counts,
+		"total":    total,
+	})
+}
+
+// GET /api/storages
+func (api *API) listStorages(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, api.storages.List())
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		log.Debug().Err(err).Msg("Could not write response")
+	}
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	writeJSON(w, code, map[string]string{"error": msg})
+}
+
+func writeInternalError(w http.ResponseWriter, err error) {
+	log.Err(err).Msg("API error")
+	writeError(w, http.StatusInternalServerError, "internal error")
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+const feedSize = 50
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomEntry struct {
+	Title   string   `xml:"title"`
+	ID      string   `xml:"id"`
+	Updated string   `xml:"updated"`
+	Link    atomLink `xml:"link"`
+	Summary string   `xml:"summary"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	NS      string      `xml:"xmlns,attr"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+// rescued returns downloaded videos that are no longer available on Youtube,
+// most recently disappeared first.
+func (api *API) rescued() ([]*index.Video, error) {
+	videos := make([]*index.Video, 0)
+
+	err := api.index.Iter(index.StatusDone, func(video *index.Video) error {
+		if video.Rescued() {
+			videos = append(videos, video)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(videos, func(i, j int) bool {
+		return videos[i].Unavailable.Since.After(videos[j].Unavailable.Since)
+	})
+
+	return videos, nil
+}
+
+// GET /api/rescued?limit=100
+func (api *API) listRescued(w http.ResponseWriter, r *http.Request) {
+	limit := defaultLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxLimit {
+			writeError(w, http.StatusBadRequest, "limit must be between 1 and 1000")
+			return
+		}
+		limit = n
+	}
+
+	videos, err := api.rescued()
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	if len(videos) > limit {
+		videos = videos[:limit]
+	}
+
+	writeJSON(w, http.StatusOK, &videosPage{Videos: videos})
+}
+
+// GET /api/rescued.atom
+func (api *API) rescuedFeed(w http.ResponseWriter, r *http.Request) {
+	videos, err := api.rescued()
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+	if len(videos) > feedSize {
+		videos = videos[:feedSize]
+	}
+
+	base := "http://" + r.Host
+	feed := &atomFeed{
+		NS:      "http://www.w3.org/2005/Atom",
+		Title:   "ytbackup: rescued videos",
+		ID:      base + "/api/rescued.atom",
+		Updated: time.Now().UTC().Format(time.RFC3339),
+		Entries: make([]atomEntry, 0, len(videos)),
+	}
+	if len(videos) > 0 {
+		feed.Updated = videos[0].Unavailable.Since.UTC().Format(time.RFC3339)
+	}
+
+	for _, video := range videos {
+		title := video.ID
+		var channel string
+		if video.Meta != nil {
+			title, channel = video.Meta.Title, video.Meta.ChannelTitle
+		}
+
+		feed.Entries = append(feed.Entries, atomEntry{
+			Title:   title,
+			ID:      "yt:video:" + video.ID,
+			Updated: video.Unavailable.Since.UTC().Format(time.RFC3339),
+			Link:    atomLink{Href: base + "/#/video/" + video.ID, Rel: "alternate"},
+			Summary: fmt.Sprintf("%s (%s): %s", title, channel, video.Unavailable.Reason),
+		})
+	}
+
+	data, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		writeInternalError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	_, _ = w.Write(append([]byte(xml.Header), data...))
+}
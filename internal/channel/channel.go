@@ -0,0 +1,240 @@
+// Package channel keeps archives of channels: title and description history,
+// avatars, banners and public playlists, in a directory per channel on a storage.
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/storages"
+)
+
+const (
+	archiveName     = "channel.json"
+	downloadTimeout = time.Minute
+)
+
+// Image kinds.
+const (
+	Avatar = "avatar"
+	Banner = "banner"
+)
+
+// Dir returns the directory of a channel archive relative to the storage root.
+func Dir(id string) string {
+	return filepath.Join("_channels", id)
+}
+
+// Version is a state of the channel identity.
+type Version struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	CustomURL   string    `json:"custom_url,omitempty"`
+	Country     string    `json:"country,omitempty"`
+	FetchedAt   time.Time `json:"fetched_at"`
+}
+
+// Image is a downloaded avatar or banner.
+type Image struct {
+	Path      string    `json:"path"`
+	URL       string    `json:"url"`
+	Hash      string    `json:"hash"`
+	Size      uint64    `json:"size"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// Playlist is a public playlist of the channel with videos in their order.
+type Playlist struct {
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description,omitempty"`
+	PublishedAt time.Time  `json:"published_at,omitempty"`
+	Videos      []string   `json:"videos"`
+	RemovedAt   *time.Time `json:"removed_at,omitempty"`
+}
+
+// Archive is the content of the channel.json file.
+type Archive struct {
+	ID        string      `json:"id"`
+	Versions  []*Version  `json:"versions"`
+	Avatars   []*Image    `json:"avatars,omitempty"`
+	Banners   []*Image    `json:"banners,omitempty"`
+	Playlists []*Playlist `json:"playlists,omitempty"`
+	SyncedAt  time.Time   `json:"synced_at"`
+}
+
+// Read parses the archive of a channel on the storage at root.
+// A missing archive results in an empty one.
+func Read(root, id string) (*Archive, error) {
+	data, err := ioutil.ReadFile(filepath.Join(root, Dir(id), archiveName))
+	if os.IsNotExist(err) {
+		return &Archive{ID: id, Versions: []*Version{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var a Archive
+	if err := json.Unmarshal(data, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// Current returns the latest version of the channel identity or nil if there is none.
+func (a *Archive) Current() *Version {
+	if len(a.Versions) == 0 {
+		return nil
+	}
+	return a.Versions[len(a.Versions)-1]
+}
+
+// AddVersion appends a version if it differs from the current one.
+func (a *Archive) AddVersion(v *Version) bool {
+	if cur := a.Current(); cur != nil &&
+		cur.Title == v.Title && cur.Description == v.Description &&
+		cur.CustomURL == v.CustomURL && cur.Country == v.Country {
+		return false
+	}
+	a.Versions = append(a.Versions, v)
+	return true
+}
+
+// SetPlaylists replaces known playlists with the current ones.
+// Playlists that are no longer public are kept and marked as removed.
+func (a *Archive) SetPlaylists(playlists []*Playlist, at time.Time) {
+	current := make(map[string]struct{})
+	for _, p := range playlists {
+		current[p.ID] = struct{}{}
+	}
+
+	for _, p := range a.Playlists {
+		if _, ok := current[p.ID]; ok || p.RemovedAt != nil {
+			continue
+		}
+		p.RemovedAt = &at
+		playlists = append(playlists, p)
+	}
+
+	a.Playlists = playlists
+}
+
+// Files returns the records of all files of the archive.
+func (a *Archive) Files(archive *index.File) []index.File {
+	files := []index.File{*archive}
+	for _, images := range [][]*Image{a.Avatars, a.Banners} {
+		for _, img := range images {
+			files = append(files, index.File{Path: img.Path, Hash: img.Hash, Size: img.Size})
+		}
+	}
+	return files
+}
+
+// Write saves the archive on the storage at root and returns its record.
+func (a *Archive) Write(root string) (*index.File, error) {
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(Dir(a.ID), archiveName)
+	fullPath := filepath.Join(root, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return nil, err
+	}
+
+	tmp := fullPath + ".part"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, fullPath); err != nil {
+		return nil, err
+	}
+
+	hash, err := storages.HashFile(fullPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &index.File{Path: path, Hash: hash, Size: uint64(len(data))}, nil
+}
+
+// SaveImage downloads an avatar or a banner into the archive directory on
+// the storage at root. The image is added to the archive only if it differs
+// from the latest one of its kind. It returns false if nothing has changed.
+func (a *Archive) SaveImage(root, kind, url string, at time.Time) (bool, error) {
+	images := &a.Avatars
+	if kind == Banner {
+		images = &a.Banners
+	}
+
+	path := filepath.Join(Dir(a.ID), fmt.Sprintf("%s-%s.jpg", kind, at.Format("20060102")))
+	fullPath := filepath.Join(root, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return false, err
+	}
+
+	tmp := fullPath + ".part"
+	size, err := download(url, tmp)
+	if err != nil {
+		_ = os.Remove(tmp)
+		return false, err
+	}
+
+	hash, err := storages.HashFile(tmp)
+	if err != nil {
+		_ = os.Remove(tmp)
+		return false, err
+	}
+
+	if n := len(*images); n > 0 && (*images)[n-1].Hash == hash {
+		return false, os.Remove(tmp)
+	}
+	if err := os.Rename(tmp, fullPath); err != nil {
+		return false, err
+	}
+
+	img := &Image{Path: path, URL: url, Hash: hash, Size: size, FetchedAt: at}
+	if n := len(*images); n > 0 && (*images)[n-1].Path == path {
+		// Changed twice a day
+		(*images)[n-1] = img
+	} else {
+		*images = append(*images, img)
+	}
+
+	return true, nil
+}
+
+func download(url, path string) (uint64, error) {
+	client := &http.Client{Timeout: downloadTimeout}
+
+	resp, err := client.Get(url)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("could not download %s: %s", url, resp.Status)
+	}
+
+	fp, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(fp, resp.Body)
+	if err != nil {
+		_ = fp.Close()
+		return 0, err
+	}
+
+	return uint64(n), fp.Close()
+}
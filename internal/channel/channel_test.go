@@ -0,0 +1,47 @@
+package channel_test
+
+import (
+	"testing"
+	"time"
+
+	"mkuznets.com/go/ytbackup/internal/channel"
+)
+
+func TestAddVersion(t *testing.T) {
+	a := &channel.Archive{}
+
+	if !a.AddVersion(&channel.Version{Title: "Old"}) {
+		t.Error("expected the first version to be added")
+	}
+	if a.AddVersion(&channel.Version{Title: "Old", FetchedAt: time.Now()}) {
+		t.Error("expected an unchanged version to be skipped")
+	}
+	if !a.AddVersion(&channel.Version{Title: "Old", Description: "About"}) {
+		t.Error("expected an edited version to be added")
+	}
+	if len(a.Versions) != 2 || a.Current().Description != "About" {
+		t.Errorf("unexpected versions: %+v", a.Versions)
+	}
+}
+
+func TestSetPlaylists(t *testing.T) {
+	at := time.Date(2020, 8, 11, 0, 0, 0, 0, time.UTC)
+	a := &channel.Archive{
+		Playlists: []*channel.Playlist{
+			{ID: "a", Videos: []string{"1", "2"}},
+			{ID: "b"},
+		},
+	}
+
+	a.SetPlaylists([]*channel.Playlist{{ID: "a", Videos: []string{"2", "1"}}}, at)
+
+	if len(a.Playlists) != 2 {
+		t.Fatalf("unexpected playlists: %+v", a.Playlists)
+	}
+	if p := a.Playlists[0]; p.ID != "a" || p.RemovedAt != nil || p.Videos[0] != "2" {
+		t.Errorf("unexpected current playlist: %+v", p)
+	}
+	if p := a.Playlists[1]; p.ID != "b" || p.RemovedAt == nil || !p.RemovedAt.Equal(at) {
+		t.Errorf("expected removed playlist to be kept: %+v", p)
+	}
+}
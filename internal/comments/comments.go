@@ -0,0 +1,130 @@
+// Package comments stores discussions under videos as JSON files
+// next to the downloaded video files.
+package comments
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/storages"
+)
+
+// Suffix is appended to the stem of the video files.
+const Suffix = ".comments.json"
+
+// Comment is a top-level comment or a reply.
+type Comment struct {
+	ID              string     `json:"id"`
+	Author          string     `json:"author"`
+	AuthorChannelID string     `json:"author_channel_id,omitempty"`
+	Text            string     `json:"text"`
+	Likes           int64      `json:"likes,omitempty"`
+	PublishedAt     time.Time  `json:"published_at"`
+	UpdatedAt       time.Time  `json:"updated_at,omitempty"`
+	ReplyCount      int64      `json:"reply_count,omitempty"`
+	Replies         []*Comment `json:"replies,omitempty"`
+}
+
+// Archive is the content of a comments file.
+type Archive struct {
+	VideoID   string     `json:"video_id"`
+	FetchedAt time.Time  `json:"fetched_at"`
+	Disabled  bool       `json:"disabled,omitempty"`
+	Threads   []*Comment `json:"threads"`
+}
+
+// Read parses a comments file. A missing file results in an empty archive.
+func Read(path, id string) (*Archive, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Archive{VideoID: id, Threads: []*Comment{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var a Archive
+	if err := json.Unmarshal(data, &a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// Thread returns a top-level comment by ID or nil if it is not archived.
+func (a *Archive) Thread(id string) *Comment {
+	for _, c := range a.Threads {
+		if c.ID == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// Merge adds new threads and replies to the archive and updates known ones.
+// Comments deleted on Youtube are kept. It returns the number of new comments.
+func (a *Archive) Merge(threads []*Comment) int {
+	added := 0
+
+	for _, t := range threads {
+		known := a.Thread(t.ID)
+		if known == nil {
+			a.Threads = append(a.Threads, t)
+			added += 1 + len(t.Replies)
+			continue
+		}
+
+		replies := known.Replies
+		*known = *t
+		known.Replies = replies
+		added += known.mergeReplies(t.Replies)
+	}
+
+	return added
+}
+
+func (c *Comment) mergeReplies(replies []*Comment) int {
+	added := 0
+	for _, r := range replies {
+		found := false
+		for i, known := range c.Replies {
+			if known.ID == r.ID {
+				c.Replies[i] = r
+				found = true
+				break
+			}
+		}
+		if !found {
+			c.Replies = append(c.Replies, r)
+			added++
+		}
+	}
+	return added
+}
+
+// Write saves the archive at path relative to the storage root and returns its record.
+func (a *Archive) Write(root, path string) (*index.File, error) {
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	fullPath := filepath.Join(root, path)
+	tmp := fullPath + ".part"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, fullPath); err != nil {
+		return nil, err
+	}
+
+	hash, err := storages.HashFile(fullPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &index.File{Path: path, Hash: hash, Size: uint64(len(data))}, nil
+}
@@ -0,0 +1,36 @@
+package comments_test
+
+import (
+	"testing"
+
+	"mkuznets.com/go/ytbackup/internal/comments"
+)
+
+func TestMerge(t *testing.T) {
+	a := &comments.Archive{
+		Threads: []*comments.Comment{
+			{ID: "a", Text: "first", Replies: []*comments.Comment{{ID: "a.1", Text: "reply"}}},
+			{ID: "b", Text: "deleted on Youtube"},
+		},
+	}
+
+	added := a.Merge([]*comments.Comment{
+		{ID: "c", Text: "new", Replies: []*comments.Comment{{ID: "c.1"}}},
+		{ID: "a", Text: "edited", Likes: 5, Replies: []*comments.Comment{{ID: "a.2"}, {ID: "a.1", Text: "edited reply"}}},
+	})
+
+	if added != 3 {
+		t.Errorf("expected 3 new comments, got %d", added)
+	}
+	if len(a.Threads) != 3 || a.Thread("b") == nil {
+		t.Fatalf("unexpected threads: %+v", a.Threads)
+	}
+
+	first := a.Thread("a")
+	if first.Text != "edited" || first.Likes != 5 {
+		t.Errorf("thread not updated: %+v", first)
+	}
+	if len(first.Replies) != 2 || first.Replies[0].Text != "edited reply" || first.Replies[1].ID != "a.2" {
+		t.Errorf("unexpected replies: %+v", first.Replies)
+	}
+}
@@ -0,0 +1,197 @@
+package control
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/rpc"
+	"sync"
+	"time"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+const dialTimeout = time.Second
+
+// ErrNotRunning is returned when the connection to the instance is lost
+// and it cannot be re-established.
+var ErrNotRunning = errors.New("ytbackup instance is not running")
+
+// Client is an Index backed by the control socket of a running instance.
+// It reconnects once per call if the instance has been restarted.
+type Client struct {
+	path string
+	mu   sync.Mutex
+	rpc  *rpc.Client
+}
+
+// Dial connects to the control socket. It fails if no instance is running.
+func Dial(path string) (*Client, error) {
+	c := &Client{path: path}
+	if err := c.dial(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Client) dial() error {
+	conn, err := net.DialTimeout("unix", c.path, dialTimeout)
+	if err != nil {
+		return err
+	}
+	c.rpc = rpc.NewClient(conn)
+	return nil
+}
+
+func (c *Client) call(method string, args, reply interface{}) error {
+	c.mu.Lock()
+	client := c.rpc
+	c.mu.Unlock()
+
+	err := client.Call(method, args, reply)
+	if !isDisconnected(err) {
+		return err
+	}
+
+	c.mu.Lock()
+	if c.rpc == client {
+		_ = client.Close()
+		if err := c.dial(); err != nil {
+			c.mu.Unlock()
+			return fmt.Errorf("%w: %v", ErrNotRunning, err)
+		}
+	}
+	client = c.rpc
+	c.mu.Unlock()
+
+	return client.Call(method, args, reply)
+}
+
+func isDisconnected(err error) bool {
+	if errors.Is(err, rpc.ErrShutdown) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
+
+func (c *Client) Push(src index.Source, ids []string) (int, error) {
+	var n int
+	err := c.call("Index.Push", &PushArgs{Source: src, IDs: ids}, &n)
+	return n, err
+}
+
+func (c *Client) PutByID(force bool, ids ...string) error {
+	return c.call("Index.PutByID", &PutByIDArgs{Force: force, IDs: ids}, &struct{}{})
+}
+
+func (c *Client) Iter(status index.Status, f func(*index.Video) error) error {
+	return iterPages(c.Page, status, f)
+}
+
+// iterPages calls f for each video with the given status, reading them page by page.
+func iterPages(page func(index.Status, string, int) ([]*index.Video, string, error), status index.Status, f func(*index.Video) error) error {
+	cursor := ""
+
+	for {
+		videos, next, err := page(status, cursor, pageSize)
+		if err != nil {
+			return err
+		}
+
+		for _, video := range videos {
+			if err := f(video); err != nil {
+				if errors.Is(err, index.ErrStop) {
+					return nil
+				}
+				return err
+			}
+		}
+
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+func (c *Client) Page(status index.Status, cursor string, n int) ([]*index.Video, string, error) {
+	var reply PageReply
+	err := c.call("Index.Page", &PageArgs{Status: status, Cursor: cursor, Limit: n}, &reply)
+	return reply.Videos, reply.Next, err
+}
+
+func (c *Client) Find(id string) (*index.Video, error) {
+	var reply FindReply
+	err := c.call("Index.Find", id, &reply)
+	return reply.Video, err
+}
+
+func (c *Client) History(id string) ([]*index.Meta, error) {
+	var versions []*index.Meta
+	err := c.call("Index.History", id, &versions)
+	return versions, err
+}
+
+func (c *Client) Count() (map[index.Status]int, error) {
+	var counts map[index.Status]int
+	err := c.call("Index.Count", struct{}{}, &counts)
+	return counts, err
+}
+
+func (c *Client) Quota(day string) (int, error) {
+	var total int
+	err := c.call("Index.Quota", day, &total)
+	return total, err
+}
+
+func (c *Client) Playlists() ([]*index.Playlist, error) {
+	var playlists []*index.Playlist
+	err := c.call("Index.Playlists", struct{}{}, &playlists)
+	return playlists, err
+}
+
+func (c *Client) Channels() ([]*index.Channel, error) {
+	var channels []*index.Channel
+	err := c.call("Index.Channels", struct{}{}, &channels)
+	return channels, err
+}
+
+func (c *Client) Members(playlistID string) ([]*index.Member, error) {
+	var members []*index.Member
+	err := c.call("Index.Members", playlistID, &members)
+	return members, err
+}
+
+func (c *Client) RequestFullScan(ids ...string) (int, error) {
+	var n int
+	err := c.call("Index.RequestFullScan", ids, &n)
+	return n, err
+}
+
+func (c *Client) Volumes() ([]*index.Volume, error) {
+	var volumes []*index.Volume
+	err := c.call("Index.Volumes", struct{}{}, &volumes)
+	return volumes, err
+}
+
+func (c *Client) Check() error {
+	return c.call("Index.Check", struct{}{}, &struct{}{})
+}
+
+// Workers returns the state of download workers of the running instance.
+func (c *Client) Workers() ([]*WorkerStatus, error) {
+	var statuses []*WorkerStatus
+	err := c.call("Daemon.Workers", struct{}{}, &statuses)
+	return statuses, err
+}
+
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.rpc.Close(); err != nil && !errors.Is(err, rpc.ErrShutdown) {
+		return err
+	}
+	return nil
+}
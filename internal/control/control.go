@@ -0,0 +1,69 @@
+// Package control implements a local API of a running `ytbackup start`.
+//
+// The daemon holds an exclusive lock on the index database, so other
+// subcommands send their reads and writes through a Unix socket instead.
+package control
+
+import (
+	"time"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+const pageSize = 1000
+
+// Index is a set of index operations available both on a local database
+// and through the control socket.
+type Index interface {
+	Push(src index.Source, ids []string) (int, error)
+	PutByID(force bool, ids ...string) error
+	Iter(status index.Status, f func(*index.Video) error) error
+	Page(status index.Status, cursor string, n int) ([]*index.Video, string, error)
+	Find(id string) (*index.Video, error)
+	History(id string) ([]*index.Meta, error)
+	Count() (map[index.Status]int, error)
+	Quota(day string) (int, error)
+	Playlists() ([]*index.Playlist, error)
+	Channels() ([]*index.Channel, error)
+	Members(playlistID string) ([]*index.Member, error)
+	RequestFullScan(ids ...string) (int, error)
+	Volumes() ([]*index.Volume, error)
+	Check() error
+	Close() error
+}
+
+type PushArgs struct {
+	Source index.Source
+	IDs    []string
+}
+
+type PutByIDArgs struct {
+	Force bool
+	IDs   []string
+}
+
+type PageArgs struct {
+	Status index.Status
+	Cursor string
+	Limit  int
+}
+
+type PageReply struct {
+	Videos []*index.Video
+	Next   string
+}
+
+type FindReply struct {
+	Video *index.Video
+}
+
+// WorkerStatus is a snapshot of a download worker of the running instance.
+type WorkerStatus struct {
+	Worker  int
+	VideoID string
+	Started time.Time
+	// Total and Downloaded are sizes in bytes, Done is the reported percentage.
+	Total      uint64
+	Downloaded uint64
+	Done       string
+}
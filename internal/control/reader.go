@@ -0,0 +1,184 @@
+package control
+
+import (
+	"errors"
+	"sync"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+// Reader is an Index for long-running commands that only read the index, e.g. `serve`.
+// Calls go through the control socket while an instance is running. Otherwise
+// the database is opened read-only for the duration of each call, so the lock
+// is never held long enough to keep `ytbackup start` from opening the index.
+type Reader struct {
+	socket string
+	path   string
+	mu     sync.Mutex
+	client *Client
+}
+
+// NewReader returns a Reader of the database at path with the control socket at socket.
+func NewReader(socket, path string) *Reader {
+	return &Reader{socket: socket, path: path}
+}
+
+// do runs f against the running instance, falling back to the local database
+// if there is none.
+func (r *Reader) do(f func(Index) error) error {
+	if client := r.connect(); client != nil {
+		err := f(client)
+		if !errors.Is(err, ErrNotRunning) {
+			return err
+		}
+		r.disconnect(client)
+	}
+
+	idx := index.New(r.path)
+	if err := idx.InitReadOnly(); err != nil {
+		return err
+	}
+	defer idx.Close()
+
+	return f(idx)
+}
+
+func (r *Reader) connect() *Client {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.client == nil {
+		if client, err := Dial(r.socket); err == nil {
+			r.client = client
+		}
+	}
+	return r.client
+}
+
+func (r *Reader) disconnect(client *Client) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.client == client {
+		_ = client.Close()
+		r.client = nil
+	}
+}
+
+func (r *Reader) Push(src index.Source, ids []string) (n int, err error) {
+	err = r.do(func(idx Index) (err error) {
+		n, err = idx.Push(src, ids)
+		return err
+	})
+	return n, err
+}
+
+func (r *Reader) PutByID(force bool, ids ...string) error {
+	return r.do(func(idx Index) error {
+		return idx.PutByID(force, ids...)
+	})
+}
+
+// Iter reads the videos page by page, so that the iteration continues from
+// the last page if the instance stops or starts in the middle of it.
+func (r *Reader) Iter(status index.Status, f func(*index.Video) error) error {
+	return iterPages(r.Page, status, f)
+}
+
+func (r *Reader) Page(status index.Status, cursor string, n int) (videos []*index.Video, next string, err error) {
+	err = r.do(func(idx Index) (err error) {
+		videos, next, err = idx.Page(status, cursor, n)
+		return err
+	})
+	return videos, next, err
+}
+
+func (r *Reader) Find(id string) (video *index.Video, err error) {
+	err = r.do(func(idx Index) (err error) {
+		video, err = idx.Find(id)
+		return err
+	})
+	return video, err
+}
+
+func (r *Reader) History(id string) (versions []*index.Meta, err error) {
+	err = r.do(func(idx Index) (err error) {
+		versions, err = idx.History(id)
+		return err
+	})
+	return versions, err
+}
+
+func (r *Reader) Count() (counts map[index.Status]int, err error) {
+	err = r.do(func(idx Index) (err error) {
+		counts, err = idx.Count()
+		return err
+	})
+	return counts, err
+}
+
+func (r *Reader) Quota(day string) (total int, err error) {
+	err = r.do(func(idx Index) (err error) {
+		total, err = idx.Quota(day)
+		return err
+	})
+	return total, err
+}
+
+func (r *Reader) Playlists() (playlists []*index.Playlist, err error) {
+	err = r.do(func(idx Index) (err error) {
+		playlists, err = idx.Playlists()
+		return err
+	})
+	return playlists, err
+}
+
+func (r *Reader) Channels() (channels []*index.Channel, err error) {
+	err = r.do(func(idx Index) (err error) {
+		channels, err = idx.Channels()
+		return err
+	})
+	return channels, err
+}
+
+func (r *Reader) Members(playlistID string) (members []*index.Member, err error) {
+	err = r.do(func(idx Index) (err error) {
+		members, err = idx.Members(playlistID)
+		return err
+	})
+	return members, err
+}
+
+func (r *Reader) RequestFullScan(ids ...string) (n int, err error) {
+	err = r.do(func(idx Index) (err error) {
+		n, err = idx.RequestFullScan(ids...)
+		return err
+	})
+	return n, err
+}
+
+func (r *Reader) Volumes() (volumes []*index.Volume, err error) {
+	err = r.do(func(idx Index) (err error) {
+		volumes, err = idx.Volumes()
+		return err
+	})
+	return volumes, err
+}
+
+func (r *Reader) Check() error {
+	return r.do(func(idx Index) error {
+		return idx.Check()
+	})
+}
+
+func (r *Reader) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.client == nil {
+		return nil
+	}
+	err := r.client.Close()
+	r.client = nil
+	return err
+}
@@ -0,0 +1,150 @@
+package control
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/rpc"
+	"os"
+
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+// Service exposes the index over RPC.
+type Service struct {
+	idx *index.Index
+}
+
+func (s *Service) Push(args *PushArgs, n *int) (err error) {
+	*n, err = s.idx.Push(args.Source, args.IDs)
+	return err
+}
+
+func (s *Service) PutByID(args *PutByIDArgs, _ *struct{}) error {
+	return s.idx.PutByID(args.Force, args.IDs...)
+}
+
+func (s *Service) Page(args *PageArgs, reply *PageReply) (err error) {
+	reply.Videos, reply.Next, err = s.idx.Page(args.Status, args.Cursor, args.Limit)
+	return err
+}
+
+func (s *Service) Find(id string, reply *FindReply) (err error) {
+	reply.Video, err = s.idx.Find(id)
+	return err
+}
+
+func (s *Service) History(id string, versions *[]*index.Meta) (err error) {
+	*versions, err = s.idx.History(id)
+	return err
+}
+
+func (s *Service) Count(_ struct{}, counts *map[index.Status]int) (err error) {
+	*counts, err = s.idx.Count()
+	return err
+}
+
+func (s *Service) Quota(day string, total *int) (err error) {
+	*total, err = s.idx.Quota(day)
+	return err
+}
+
+func (s *Service) Playlists(_ struct{}, playlists *[]*index.Playlist) (err error) {
+	*playlists, err = s.idx.Playlists()
+	return err
+}
+
+func (s *Service) Channels(_ struct{}, channels *[]*index.Channel) (err error) {
+	*channels, err = s.idx.Channels()
+	return err
+}
+
+func (s *Service) Members(playlistID string, members *[]*index.Member) (err error) {
+	*members, err = s.idx.Members(playlistID)
+	return err
+}
+
+func (s *Service) RequestFullScan(ids []string, n *int) (err error) {
+	*n, err = s.idx.RequestFullScan(ids...)
+	return err
+}
+
+func (s *Service) Volumes(_ struct{}, volumes *[]*index.Volume) (err error) {
+	*volumes, err = s.idx.Volumes()
+	return err
+}
+
+func (s *Service) Check(_ struct{}, _ *struct{}) error {
+	return s.idx.Check()
+}
+
+// Daemon exposes the state of the running instance over RPC.
+type Daemon struct {
+	workers func() []*WorkerStatus
+}
+
+func (d *Daemon) Workers(_ struct{}, statuses *[]*WorkerStatus) error {
+	*statuses = d.workers()
+	return nil
+}
+
+type Server struct {
+	path     string
+	listener net.Listener
+	rpc      *rpc.Server
+}
+
+// Listen creates the control socket at the given path.
+// A leftover socket of a previous instance is removed.
+func Listen(path string, idx *index.Index, workers func() []*WorkerStatus) (*Server, error) {
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("could not remove control socket: %v", err)
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("could not create control socket: %v", err)
+	}
+	if err := os.Chmod(path, os.FileMode(0600)); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	srv := rpc.NewServer()
+	if err := srv.RegisterName("Index", &Service{idx: idx}); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+	if err := srv.RegisterName("Daemon", &Daemon{workers: workers}); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	return &Server{path: path, listener: listener, rpc: srv}, nil
+}
+
+// Serve accepts connections until the context is cancelled.
+func (s *Server) Serve(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		if err := s.listener.Close(); err != nil {
+			log.Debug().Err(err).Msg("Could not close control socket")
+		}
+	}()
+
+	log.Debug().Str("path", s.path).Msg("Control socket: listening")
+
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Err(err).Msg("Control socket error")
+			}
+			break
+		}
+		go s.rpc.ServeConn(conn)
+	}
+
+	log.Debug().Msg("Control socket: closed")
+}
@@ -0,0 +1,48 @@
+package index
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+	bolt "go.etcd.io/bbolt"
+)
+
+// RecordAvailability updates the availability of checked videos. Reasons map
+// IDs of unavailable videos to the reason, other checked videos are available.
+// It returns the number of videos that have become unavailable since the last check.
+func (st *Index) RecordAvailability(ids []string, reasons map[string]string, at time.Time) (int, error) {
+	rescued := 0
+
+	err := st.db.Update(func(tx *bolt.Tx) error {
+		for _, id := range ids {
+			video, err := getByID(tx, []byte(id))
+			if err != nil {
+				return err
+			}
+			if video == nil {
+				continue
+			}
+
+			video.CheckedAt = &at
+
+			reason, unavailable := reasons[id]
+			switch {
+			case unavailable && video.Unavailable == nil:
+				video.Unavailable = &Unavailability{Since: at, Reason: reason}
+				rescued++
+			case unavailable:
+				video.Unavailable.Reason = reason
+			case video.Unavailable != nil:
+				log.Info().Str("id", id).Msg("Video is available again")
+				video.Unavailable = nil
+			}
+
+			if _, err := put(tx, video, true); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return rescued, err
+}
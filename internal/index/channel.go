@@ -0,0 +1,66 @@
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Channel is a record of a channel archive kept on a storage.
+type Channel struct {
+	ID    string `json:"id"`
+	Title string `json:"title,omitempty"`
+	// Storage is the ID of the storage with the channel directory.
+	Storage string `json:"storage,omitempty"`
+	// Files are paths of the channel files relative to the storage root.
+	Files    []File    `json:"files,omitempty"`
+	SyncedAt time.Time `json:"synced_at,omitempty"`
+	// MissingSince is set when the channel disappears from Youtube.
+	MissingSince *time.Time `json:"missing_since,omitempty"`
+}
+
+// Channel returns the archive record of a channel or nil if it has not been archived yet.
+func (st *Index) Channel(id string) (channel *Channel, err error) {
+	err = st.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(bucketChannels).Get([]byte(id))
+		if data == nil {
+			return nil
+		}
+		channel = &Channel{}
+		if err := json.Unmarshal(data, channel); err != nil {
+			return fmt.Errorf("could not parse channel %s: %v", id, err)
+		}
+		return nil
+	})
+	return channel, err
+}
+
+func (st *Index) PutChannel(channel *Channel) error {
+	value, err := json.Marshal(channel)
+	if err != nil {
+		return fmt.Errorf("could not serialise Channel: %v", err)
+	}
+	return st.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketChannels).Put([]byte(channel.ID), value)
+	})
+}
+
+// Channels returns all archived channels.
+func (st *Index) Channels() ([]*Channel, error) {
+	channels := make([]*Channel, 0)
+
+	err := st.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketChannels).ForEach(func(k, v []byte) error {
+			var channel Channel
+			if err := json.Unmarshal(v, &channel); err != nil {
+				return fmt.Errorf("could not parse channel %s: %v", k, err)
+			}
+			channels = append(channels, &channel)
+			return nil
+		})
+	})
+
+	return channels, err
+}
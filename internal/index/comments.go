@@ -0,0 +1,26 @@
+package index
+
+import (
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// PutComments records the comments file of a downloaded video.
+// A nil file only records the check, e.g. after a failure.
+func (st *Index) PutComments(id string, file *File, at time.Time) error {
+	return st.db.Update(func(tx *bolt.Tx) error {
+		video, err := getByID(tx, []byte(id))
+		if err != nil || video == nil {
+			return err
+		}
+
+		if file != nil {
+			video.SetFile(*file)
+		}
+		video.CommentsAt = &at
+
+		_, err = put(tx, video, true)
+		return err
+	})
+}
@@ -31,17 +31,46 @@ func (v *Video) Row(full bool) string {
 		)
 	}
 
-	line := fmt.Sprintf("%s\t%s%s\t%s", v.ID, v.Status, meta, trFunc(v.shortReason(), 90))
+	line := fmt.Sprintf("%s\t%s\t%s%s\t%s", v.ID, v.Status, v.MediaType(), meta, trFunc(v.why(), 90))
 	line = strings.ReplaceAll(line, "\n", " ")
 
 	return line
 }
 
-func (v *Video) shortReason() string {
-	if v.Reason == "" {
-		return v.Reason
+// why explains the status of a video: the failure reason for failed ones,
+// the last failed attempt for ones waiting for a retry and the reason
+// downloaded videos have disappeared from Youtube.
+func (v *Video) why() string {
+	if v.Rescued() {
+		return fmt.Sprintf("unavailable since %s: %s", v.Unavailable.Since.Local().Format("2006-01-02"), v.Unavailable.Reason)
 	}
-	r := reContentWarning.ReplaceAllString(v.Reason, "$1")
+	if v.Status == StatusScheduled && v.ScheduledAt != nil {
+		return "scheduled, next check at " + v.ScheduledAt.Local().Format("2006-01-02 15:04")
+	}
+	if v.Reason != "" || len(v.Attempts) == 0 {
+		return shortReason(v.Reason)
+	}
+	if v.Status != StatusEnqueued && v.Status != StatusInProgress {
+		return ""
+	}
+
+	last := v.Attempts[len(v.Attempts)-1]
+	s := fmt.Sprintf("attempt %d", v.Attempt)
+	if last.Reason != "" {
+		s += ", " + last.Reason
+	}
+	if v.RetryAfter != nil && v.Status == StatusEnqueued {
+		s += ", next at " + v.RetryAfter.Local().Format("2006-01-02 15:04")
+	}
+
+	return s + ": " + shortReason(last.Error)
+}
+
+func shortReason(reason string) string {
+	if reason == "" {
+		return reason
+	}
+	r := reContentWarning.ReplaceAllString(reason, "$1")
 	r = reYoutubeSaid.ReplaceAllString(r, "$1")
 	r = reSpaces.ReplaceAllString(r, " ")
 	r = reSorry.ReplaceAllString(r, "$1")
@@ -0,0 +1,110 @@
+package index
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// RefreshMeta updates metadata of downloaded videos. A version is added
+// to the history only if it has been edited, metadata taken before
+// the history was kept is saved as the first version.
+// Videos mapped to nil metadata are only marked as refreshed.
+// It returns the number of edited videos.
+func (st *Index) RefreshMeta(metas map[string]*Meta, at time.Time) (int, error) {
+	edited := 0
+
+	err := st.db.Update(func(tx *bolt.Tx) error {
+		for id, meta := range metas {
+			video, err := getByID(tx, []byte(id))
+			if err != nil {
+				return err
+			}
+			if video == nil {
+				continue
+			}
+			video.RefreshedAt = &at
+
+			if meta == nil {
+				if _, err := put(tx, video, true); err != nil {
+					return err
+				}
+				continue
+			}
+
+			versions, err := getHistory(tx, id)
+			if err != nil {
+				return err
+			}
+			if len(versions) == 0 && video.Meta != nil {
+				if err := putVersion(tx, id, video.Meta); err != nil {
+					return err
+				}
+			}
+
+			if video.Meta == nil || video.Meta.Edited(meta) {
+				if meta.FetchedAt.IsZero() {
+					meta.FetchedAt = at
+				}
+				if err := putVersion(tx, id, meta); err != nil {
+					return err
+				}
+				edited++
+			} else {
+				meta.FetchedAt = video.Meta.FetchedAt
+			}
+			video.Meta = meta
+
+			if _, err := put(tx, video, true); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return edited, err
+}
+
+// History returns the known versions of metadata of a video, oldest first.
+func (st *Index) History(id string) (versions []*Meta, err error) {
+	err = st.db.View(func(tx *bolt.Tx) error {
+		versions, err = getHistory(tx, id)
+		return err
+	})
+	return versions, err
+}
+
+func historyKey(id string, at time.Time) []byte {
+	return []byte(fmt.Sprintf("%s::%020d", id, at.UnixNano()))
+}
+
+func putVersion(tx *bolt.Tx, id string, meta *Meta) error {
+	value, err := json.Marshal(meta)
+	if err != nil {
+		return fmt.Errorf("could not serialise Meta: %v", err)
+	}
+	at := meta.FetchedAt
+	if at.IsZero() {
+		at = time.Unix(0, 0)
+	}
+	return tx.Bucket(bucketHistory).Put(historyKey(id, at), value)
+}
+
+func getHistory(tx *bolt.Tx, id string) ([]*Meta, error) {
+	versions := make([]*Meta, 0)
+	prefix := []byte(id + "::")
+
+	cur := tx.Bucket(bucketHistory).Cursor()
+	for k, v := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cur.Next() {
+		var meta Meta
+		if err := json.Unmarshal(v, &meta); err != nil {
+			return nil, fmt.Errorf("could not parse metadata version %s: %v", k, err)
+		}
+		versions = append(versions, &meta)
+	}
+
+	return versions, nil
+}
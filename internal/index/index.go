@@ -17,18 +17,15 @@ import (
 )
 
 var (
-	maxAttempts    = 3
-	retryDelay     = 30 * time.Second
-	bucketItems    = []byte("items")
-	bucketStatuses = []byte("statuses")
-	ErrStop        = errors.New("iteration stopped")
-)
-
-type RetryMode uint8
-
-const (
-	RetryInfinite RetryMode = 1 + iota
-	RetryLimited
+	bucketItems     = []byte("items")
+	bucketStatuses  = []byte("statuses")
+	bucketQuota     = []byte("quota")
+	bucketPlaylists = []byte("playlists")
+	bucketVolumes   = []byte("volumes")
+	bucketHistory   = []byte("history")
+	bucketMembers   = []byte("members")
+	bucketChannels  = []byte("channels")
+	ErrStop         = errors.New("iteration stopped")
 )
 
 type Index struct {
@@ -66,7 +63,7 @@ func (st *Index) Init() error {
 	}
 
 	err = db.Update(func(tx *bolt.Tx) error {
-		for _, name := range [2][]byte{bucketItems, bucketStatuses} {
+		for _, name := range [][]byte{bucketItems, bucketStatuses, bucketQuota, bucketPlaylists, bucketVolumes, bucketHistory, bucketMembers, bucketChannels} {
 			_, err := tx.CreateBucketIfNotExists(name)
 			if err != nil {
 				return fmt.Errorf("could not create index bucket: %s", err)
@@ -81,9 +78,31 @@ func (st *Index) Init() error {
 	ctx, cancel := context.WithCancel(context.Background())
 	st.cancel = cancel
 
-	st.wg.Add(1)
+	st.wg.Add(2)
 	go st.ensureTimeout(ctx)
+	go st.ensureScheduled(ctx)
+
+	st.db = db
+
+	return nil
+}
+
+// InitReadOnly opens an existing index database for reading. Unlike Init,
+// it only takes a shared lock and runs no background maintenance.
+func (st *Index) InitReadOnly() error {
+	if st.db != nil {
+		return nil
+	}
+
+	db, err := bolt.Open(st.path, os.FileMode(0644), &bolt.Options{Timeout: 3 * time.Second, ReadOnly: true})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return fmt.Errorf("index database is locked (probably, by another ytbackup instance)")
+		}
+		return fmt.Errorf("could not open index database at %s: %v", st.path, err)
+	}
 
+	st.cancel = func() {}
 	st.db = db
 
 	return nil
@@ -104,17 +123,29 @@ func (st *Index) Close() error {
 	return nil
 }
 
-func (st *Index) Push(ids []string) (int, error) {
+// Push adds new videos found from the source. Known videos only get the source recorded.
+// It returns the number of new videos.
+func (st *Index) Push(src Source, ids []string) (int, error) {
 	total := 0
+	if src.FirstSeen.IsZero() {
+		src.FirstSeen = time.Now()
+	}
 
 	err := st.db.Update(func(tx *bolt.Tx) error {
 		for _, id := range ids {
-			ok, err := put(tx, &Video{ID: id, Status: StatusNew}, false)
+			video, err := getByID(tx, []byte(id))
 			if err != nil {
 				return err
 			}
-			if ok {
+			if video == nil {
 				total++
+				video = &Video{ID: id, Status: StatusNew}
+			}
+			if !video.AddSource(src) {
+				continue
+			}
+			if _, err := put(tx, video, true); err != nil {
+				return err
 			}
 		}
 		return nil
@@ -178,7 +209,81 @@ func (st *Index) Get(status Status, n int) ([]*Video, error) {
 	return videos, nil
 }
 
-func (st *Index) Retry(id string, mode RetryMode) error {
+// Page returns up to n videos with the given status that follow the cursor.
+// An empty cursor starts from the beginning, an empty next cursor means
+// there are no more videos.
+func (st *Index) Page(status Status, cursor string, n int) (videos []*Video, next string, err error) {
+	if n < 1 {
+		return nil, "", errors.New("page size must be positive")
+	}
+	videos = make([]*Video, 0, n)
+
+	err = st.db.View(func(tx *bolt.Tx) error {
+		cur := tx.Bucket(bucketStatuses).Cursor()
+		prefix := []byte(status)
+
+		key, videoID := cur.Seek(prefix)
+		if cursor != "" {
+			key, videoID = cur.Seek([]byte(cursor))
+			if key != nil && string(key) == cursor {
+				key, videoID = cur.Next()
+			}
+		}
+
+		for ; key != nil && bytes.HasPrefix(key, prefix); key, videoID = cur.Next() {
+			if len(videos) >= n {
+				next = string(videos[len(videos)-1].StatusKey())
+				return nil
+			}
+
+			video, err := getByID(tx, videoID)
+			if err != nil {
+				return err
+			}
+			if video == nil {
+				return fmt.Errorf("inconsistent index: %s", videoID)
+			}
+			videos = append(videos, video)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return videos, next, nil
+}
+
+// Find returns a video by ID or nil if it does not exist.
+func (st *Index) Find(id string) (video *Video, err error) {
+	err = st.db.View(func(tx *bolt.Tx) error {
+		video, err = getByID(tx, []byte(id))
+		return err
+	})
+	return video, err
+}
+
+// Count returns the number of videos for each status.
+func (st *Index) Count() (map[Status]int, error) {
+	counts := make(map[Status]int)
+
+	err := st.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketStatuses).ForEach(func(k, v []byte) error {
+			ps := bytes.SplitN(k, []byte("::"), 2)
+			counts[Status(ps[0])]++
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// Retry records a failed attempt and enqueues the video again after a delay
+// defined by the policy. The video is marked as failed once the policy is exhausted.
+func (st *Index) Retry(id string, policy RetryPolicy, attempt Attempt) error {
 	return st.db.Update(func(tx *bolt.Tx) error {
 		video, err := getByID(tx, []byte(id))
 		if err != nil {
@@ -188,20 +293,25 @@ func (st *Index) Retry(id string, mode RetryMode) error {
 			return nil
 		}
 
+		if attempt.Time.IsZero() {
+			attempt.Time = time.Now()
+		}
+		video.AddAttempt(attempt)
+		video.Attempt++
+		video.Deadline = nil
 		video.Status = StatusEnqueued
 
-		if mode == RetryLimited {
-			video.Attempt++
-			after := time.Now().Add(retryDelay)
+		if policy.Exhausted(video.Attempt, video.Attempts[0].Time, attempt.Time) {
+			log.Info().Str("id", video.ID).Int("attempts", video.Attempt).Msg("Retry limit reached")
+			video.Status = StatusFailed
+			video.Reason = attempt.Error
+			video.RetryAfter = nil
+		} else {
+			after := attempt.Time.Add(policy.Backoff(video.Attempt))
 			video.RetryAfter = &after
-
-			if video.Attempt > maxAttempts {
-				log.Info().Str("id", video.ID).Msg("Retry limit reached")
-				video.Status = StatusFailed
-			}
+			log.Info().Str("id", id).Time("after", after).Msg("Retry later")
 		}
 
-		log.Info().Str("id", id).Msg("Retry later")
 		if _, err := put(tx, video, true); err != nil {
 			return err
 		}
@@ -223,6 +333,58 @@ func (st *Index) Put(videos ...*Video) error {
 	})
 }
 
+// AddStorage records a copy of a video on the storage.
+func (st *Index) AddStorage(id string, storage Storage) error {
+	return st.db.Update(func(tx *bolt.Tx) error {
+		video, err := getByID(tx, []byte(id))
+		if err != nil {
+			return err
+		}
+		if video == nil {
+			return fmt.Errorf("video %s does not exist", id)
+		}
+		if video.Status != StatusDone {
+			return fmt.Errorf("video %s is no longer downloaded", id)
+		}
+		if video.HasStorage(storage.ID) {
+			return nil
+		}
+
+		video.Storages = append(video.Storages, storage)
+		_, err = put(tx, video, true)
+		return err
+	})
+}
+
+// MoveStorage replaces a copy of a video on one storage with a copy on another.
+// It is a no-op if the video has already been moved.
+func (st *Index) MoveStorage(id, from, to string) error {
+	return st.db.Update(func(tx *bolt.Tx) error {
+		video, err := getByID(tx, []byte(id))
+		if err != nil {
+			return err
+		}
+		if video == nil {
+			return fmt.Errorf("video %s does not exist", id)
+		}
+
+		if video.HasStorage(to) && !video.HasStorage(from) {
+			return nil
+		}
+
+		sts := make([]Storage, 0, len(video.Storages))
+		for _, s := range video.Storages {
+			if s.ID != from && s.ID != to {
+				sts = append(sts, s)
+			}
+		}
+		video.Storages = append(sts, Storage{ID: to})
+
+		_, err = put(tx, video, true)
+		return err
+	})
+}
+
 func (st *Index) PutByID(force bool, ids ...string) error {
 	return st.db.Update(func(tx *bolt.Tx) error {
 		existing := make([]string, 0)
@@ -241,8 +403,12 @@ func (st *Index) PutByID(force bool, ids ...string) error {
 			return fmt.Errorf("IDs already exist: %v", existing)
 		}
 
+		src := Source{Kind: SourceManual, FirstSeen: time.Now()}
+
 		for _, id := range ids {
 			video := &Video{ID: id, Status: StatusNew}
+			video.AddSource(src)
+
 			if _, err := put(tx, video, true); err != nil {
 				return err
 			}
@@ -284,7 +450,7 @@ func (st *Index) ensureTimeoutOnce() error {
 
 			now := time.Now()
 
-			if beat, ok := st.beats[video.ID]; ok && beat.After(now) {
+			if beat, ok := st.beat(video.ID); ok && beat.After(now) {
 				video.Deadline = &beat
 				if _, err := put(tx, video, true); err != nil {
 					return err
@@ -306,13 +472,56 @@ func (st *Index) ensureTimeoutOnce() error {
 	})
 }
 
+func (st *Index) ensureScheduled(ctx context.Context) {
+	ticker.New(st.timeoutCheckPeriod).MustDo(ctx, func() error {
+		if err := st.ensureScheduledOnce(); err != nil {
+			log.Warn().Err(err).Msg("ensureScheduled error")
+		}
+		return nil
+	})
+
+	st.wg.Done()
+}
+
+// ensureScheduledOnce moves scheduled videos that are due back to NEW,
+// so that their state is fetched again.
+func (st *Index) ensureScheduledOnce() error {
+	return st.db.Update(func(tx *bolt.Tx) error {
+		return iterItems(tx, StatusScheduled, func(video *Video) error {
+			if video.ScheduledAt != nil && video.ScheduledAt.After(time.Now()) {
+				return nil
+			}
+
+			log.Debug().Str("id", video.ID).Msg("Scheduled video is due")
+			video.Status = StatusNew
+			if _, err := put(tx, video, true); err != nil {
+				return err
+			}
+			return nil
+		})
+	})
+}
+
+// Beat extends the deadline of an in-progress video.
+// Beats of concurrent downloads are tracked independently.
 func (st *Index) Beat(id string) {
 	st.beatLock.Lock()
 	defer st.beatLock.Unlock()
-	for k := range st.beats {
-		delete(st.beats, k)
+
+	now := time.Now()
+	for k, beat := range st.beats {
+		if beat.Before(now) {
+			delete(st.beats, k)
+		}
 	}
-	st.beats[id] = time.Now().Add(st.timeout)
+	st.beats[id] = now.Add(st.timeout)
+}
+
+func (st *Index) beat(id string) (time.Time, bool) {
+	st.beatLock.Lock()
+	defer st.beatLock.Unlock()
+	beat, ok := st.beats[id]
+	return beat, ok
 }
 
 func (st *Index) Check() error {
@@ -385,6 +594,10 @@ func put(tx *bolt.Tx, video *Video, replace bool) (ok bool, err error) {
 		if err := tx.Bucket(bucketStatuses).Delete(oldVideo.StatusKey()); err != nil {
 			return false, err
 		}
+		// Sources are never removed, keep the ones recorded since the video was read
+		for _, src := range oldVideo.Sources {
+			video.AddSource(src)
+		}
 	}
 
 	value, err := json.Marshal(video)
@@ -0,0 +1,115 @@
+package index
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"time"
+)
+
+// Info is a subset of an info.json file written by youtube-dl.
+type Info struct {
+	Title       string                     `json:"title"`
+	Description string                     `json:"description"`
+	ChannelID   string                     `json:"channel_id"`
+	Uploader    string                     `json:"uploader"`
+	Tags        []string                   `json:"tags"`
+	UploadDate  string                     `json:"upload_date"`
+	Duration    float64                    `json:"duration"`
+	Categories  []string                   `json:"categories"`
+	Language    string                     `json:"language"`
+	License     string                     `json:"license"`
+	Subtitles   map[string]json.RawMessage `json:"subtitles"`
+	Thumbnails  []struct {
+		URL    string `json:"url"`
+		Width  int    `json:"width"`
+		Height int    `json:"height"`
+	} `json:"thumbnails"`
+	ViewCount    *uint64 `json:"view_count"`
+	LikeCount    *uint64 `json:"like_count"`
+	CommentCount *uint64 `json:"comment_count"`
+}
+
+// ReadInfo parses an info.json file.
+func ReadInfo(path string) (*Info, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var info Info
+	if err := json.Unmarshal(data, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// Backfill sets the fields of metadata missing from the index
+// from an info.json file. Fields already known are kept.
+// It returns false if nothing has been changed.
+func (m *Meta) Backfill(info *Info) bool {
+	changed := false
+	setString := func(dst *string, value string) {
+		if *dst == "" && value != "" {
+			*dst = value
+			changed = true
+		}
+	}
+
+	setString(&m.Title, info.Title)
+	setString(&m.Description, info.Description)
+	setString(&m.ChannelID, info.ChannelID)
+	setString(&m.ChannelTitle, info.Uploader)
+	setString(&m.Language, info.Language)
+	if len(info.Categories) > 0 {
+		setString(&m.Category, info.Categories[0])
+	}
+
+	if m.License == "" {
+		// youtube-dl only reports non-standard licenses
+		if strings.Contains(info.License, "Creative Commons") {
+			m.License = LicenseCreativeCommon
+		} else {
+			m.License = LicenseYoutube
+		}
+		changed = true
+	}
+
+	if len(m.Tags) == 0 && len(info.Tags) > 0 {
+		m.Tags = info.Tags
+		changed = true
+	}
+	if m.PublishedAt.IsZero() {
+		if t, err := time.Parse("20060102", info.UploadDate); err == nil {
+			m.PublishedAt = t
+			changed = true
+		}
+	}
+	if m.Duration == 0 && info.Duration > 0 {
+		m.Duration = int(info.Duration + 0.5)
+		changed = true
+	}
+	if !m.Captions && len(info.Subtitles) > 0 {
+		m.Captions = true
+		changed = true
+	}
+
+	if len(m.Thumbnails) == 0 && len(info.Thumbnails) > 0 {
+		for _, t := range info.Thumbnails {
+			m.Thumbnails = append(m.Thumbnails, Thumbnail{URL: t.URL, Width: t.Width, Height: t.Height})
+		}
+		changed = true
+	}
+
+	if m.Statistics == nil && info.ViewCount != nil {
+		m.Statistics = &Statistics{Views: *info.ViewCount}
+		if info.LikeCount != nil {
+			m.Statistics.Likes = *info.LikeCount
+		}
+		if info.CommentCount != nil {
+			m.Statistics.Comments = *info.CommentCount
+		}
+		changed = true
+	}
+
+	return changed
+}
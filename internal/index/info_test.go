@@ -0,0 +1,61 @@
+package index_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+const infoJSON = `{
+	"title": "Title",
+	"uploader": "Channel",
+	"channel_id": "UC123",
+	"upload_date": "20200811",
+	"duration": 61.6,
+	"categories": ["Science & Technology"],
+	"license": "Creative Commons Attribution license (reuse allowed)",
+	"subtitles": {"en": []},
+	"thumbnails": [{"url": "https://i.ytimg.com/vi/abc/hqdefault.jpg", "width": 480, "height": 360}],
+	"view_count": 1000,
+	"like_count": 10
+}`
+
+func TestBackfill(t *testing.T) {
+	var info index.Info
+	if err := json.Unmarshal([]byte(infoJSON), &info); err != nil {
+		t.Fatal(err)
+	}
+
+	meta := &index.Meta{Title: "Edited title"}
+	if !meta.Backfill(&info) {
+		t.Fatal("expected metadata to change")
+	}
+
+	if meta.Title != "Edited title" {
+		t.Errorf("known title overwritten: %q", meta.Title)
+	}
+	if meta.ChannelTitle != "Channel" || meta.ChannelID != "UC123" {
+		t.Errorf("unexpected channel: %q (%s)", meta.ChannelTitle, meta.ChannelID)
+	}
+	if !meta.PublishedAt.Equal(time.Date(2020, 8, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected publication date: %s", meta.PublishedAt)
+	}
+	if meta.Duration != 62 {
+		t.Errorf("unexpected duration: %d", meta.Duration)
+	}
+	if meta.Category != "Science & Technology" || meta.License != index.LicenseCreativeCommon || !meta.Captions {
+		t.Errorf("unexpected category, license or captions: %+v", meta)
+	}
+	if len(meta.Thumbnails) != 1 || meta.Thumbnails[0].Width != 480 {
+		t.Errorf("unexpected thumbnails: %+v", meta.Thumbnails)
+	}
+	if meta.Statistics == nil || meta.Statistics.Views != 1000 || meta.Statistics.Likes != 10 || meta.Statistics.Comments != 0 {
+		t.Errorf("unexpected statistics: %+v", meta.Statistics)
+	}
+
+	if meta.Backfill(&info) {
+		t.Error("expected the second backfill to change nothing")
+	}
+}
@@ -0,0 +1,130 @@
+package index
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Member records that a video has been found in a source playlist.
+type Member struct {
+	PlaylistID string `json:"playlist_id"`
+	VideoID    string `json:"video_id"`
+	// Position is the zero-based position of the video as of the last full scan,
+	// or of the scan it was found by if it has been added since.
+	Position int64 `json:"position"`
+	// AddedAt is the time the video was added to the playlist.
+	AddedAt time.Time `json:"added_at"`
+	// RemovedAt is set when the video is no longer in the playlist.
+	RemovedAt *time.Time `json:"removed_at,omitempty"`
+}
+
+func memberKey(playlistID, videoID string) []byte {
+	return []byte(playlistID + "::" + videoID)
+}
+
+// PutMembers records videos found in a playlist, removed ones are restored.
+// Known members keep the time they were added unless it is set. Positions of
+// known members are only updated by a full scan: an incremental one does not
+// see the pages the other members have been shifted to.
+func (st *Index) PutMembers(playlistID string, members []*Member, full bool) error {
+	return st.db.Update(func(tx *bolt.Tx) error {
+		for _, m := range members {
+			m.PlaylistID = playlistID
+
+			known, err := getMember(tx, playlistID, m.VideoID)
+			if err != nil {
+				return err
+			}
+			if known != nil && m.AddedAt.IsZero() {
+				m.AddedAt = known.AddedAt
+			}
+			if known != nil && !full {
+				m.Position = known.Position
+			}
+
+			if err := putMember(tx, m); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RemoveMembers marks all videos of a playlist except the kept ones as removed.
+// It returns the number of removed videos.
+func (st *Index) RemoveMembers(playlistID string, keep map[string]struct{}, at time.Time) (int, error) {
+	members, err := st.Members(playlistID)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	err = st.db.Update(func(tx *bolt.Tx) error {
+		for _, m := range members {
+			if _, ok := keep[m.VideoID]; ok || m.RemovedAt != nil {
+				continue
+			}
+			m.RemovedAt = &at
+			if err := putMember(tx, m); err != nil {
+				return err
+			}
+			removed++
+		}
+		return nil
+	})
+
+	return removed, err
+}
+
+// Members returns known videos of a playlist ordered by position,
+// removed ones follow the current ones.
+func (st *Index) Members(playlistID string) ([]*Member, error) {
+	members := make([]*Member, 0)
+	prefix := []byte(playlistID + "::")
+
+	err := st.db.View(func(tx *bolt.Tx) error {
+		cur := tx.Bucket(bucketMembers).Cursor()
+		for k, v := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cur.Next() {
+			var m Member
+			if err := json.Unmarshal(v, &m); err != nil {
+				return fmt.Errorf("could not parse member %s: %v", k, err)
+			}
+			members = append(members, &m)
+		}
+		return nil
+	})
+
+	sort.SliceStable(members, func(i, j int) bool {
+		if removed := members[i].RemovedAt != nil; removed != (members[j].RemovedAt != nil) {
+			return !removed
+		}
+		return members[i].Position < members[j].Position
+	})
+
+	return members, err
+}
+
+func getMember(tx *bolt.Tx, playlistID, videoID string) (*Member, error) {
+	data := tx.Bucket(bucketMembers).Get(memberKey(playlistID, videoID))
+	if data == nil {
+		return nil, nil
+	}
+	var m Member
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("could not parse member %s: %v", memberKey(playlistID, videoID), err)
+	}
+	return &m, nil
+}
+
+func putMember(tx *bolt.Tx, m *Member) error {
+	value, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("could not serialise Member: %v", err)
+	}
+	return tx.Bucket(bucketMembers).Put(memberKey(m.PlaylistID, m.VideoID), value)
+}
@@ -0,0 +1,140 @@
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Playlist is a crawling checkpoint of a playlist.
+type Playlist struct {
+	ID                string    `json:"id"`
+	Title             string    `json:"title,omitempty"`
+	LastScan          time.Time `json:"last_scan,omitempty"`
+	LastFullScan      time.Time `json:"last_full_scan,omitempty"`
+	ItemCount         int64     `json:"item_count,omitempty"`
+	ETag              string    `json:"etag,omitempty"`
+	FullScanRequested bool      `json:"full_scan_requested,omitempty"`
+}
+
+// FullScanDue reports whether all pages of the playlist have to be scanned.
+// A zero interval disables periodic full scans.
+func (p *Playlist) FullScanDue(interval time.Duration) bool {
+	if p.FullScanRequested || p.LastFullScan.IsZero() {
+		return true
+	}
+	return interval > 0 && time.Since(p.LastFullScan) > interval
+}
+
+// Playlist returns the checkpoint of a playlist or nil if it has not been crawled yet.
+func (st *Index) Playlist(id string) (playlist *Playlist, err error) {
+	err = st.db.View(func(tx *bolt.Tx) error {
+		playlist, err = getPlaylist(tx, id)
+		return err
+	})
+	return playlist, err
+}
+
+// SaveScan records the result of a playlist scan. The checkpoint is updated
+// in place, so a full scan requested while the scan was running is kept
+// unless the scan itself was a full one.
+func (st *Index) SaveScan(scan *Playlist, full bool) error {
+	return st.db.Update(func(tx *bolt.Tx) error {
+		playlist, err := getPlaylist(tx, scan.ID)
+		if err != nil {
+			return err
+		}
+		if playlist == nil {
+			playlist = &Playlist{ID: scan.ID}
+		}
+
+		playlist.Title = scan.Title
+		playlist.ETag = scan.ETag
+		playlist.ItemCount = scan.ItemCount
+		playlist.LastScan = scan.LastScan
+		if full {
+			playlist.LastFullScan = scan.LastScan
+			playlist.FullScanRequested = false
+		}
+
+		return putPlaylist(tx, playlist)
+	})
+}
+
+func (st *Index) Playlists() ([]*Playlist, error) {
+	playlists := make([]*Playlist, 0)
+
+	err := st.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketPlaylists).ForEach(func(k, v []byte) error {
+			var playlist Playlist
+			if err := json.Unmarshal(v, &playlist); err != nil {
+				return fmt.Errorf("could not parse playlist %s: %v", k, err)
+			}
+			playlists = append(playlists, &playlist)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return playlists, nil
+}
+
+// RequestFullScan makes the crawler walk all pages of the given playlists
+// (or all known playlists if none are given) on its next run.
+func (st *Index) RequestFullScan(ids ...string) (int, error) {
+	total := 0
+
+	err := st.db.Update(func(tx *bolt.Tx) error {
+		if len(ids) == 0 {
+			err := tx.Bucket(bucketPlaylists).ForEach(func(k, v []byte) error {
+				ids = append(ids, string(k))
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, id := range ids {
+			playlist, err := getPlaylist(tx, id)
+			if err != nil {
+				return err
+			}
+			if playlist == nil {
+				return fmt.Errorf("unknown playlist: %s", id)
+			}
+			playlist.FullScanRequested = true
+			if err := putPlaylist(tx, playlist); err != nil {
+				return err
+			}
+			total++
+		}
+		return nil
+	})
+
+	return total, err
+}
+
+func getPlaylist(tx *bolt.Tx, id string) (*Playlist, error) {
+	data := tx.Bucket(bucketPlaylists).Get([]byte(id))
+	if data == nil {
+		return nil, nil
+	}
+	var playlist Playlist
+	if err := json.Unmarshal(data, &playlist); err != nil {
+		return nil, fmt.Errorf("could not parse playlist %s: %v", id, err)
+	}
+	return &playlist, nil
+}
+
+func putPlaylist(tx *bolt.Tx, playlist *Playlist) error {
+	value, err := json.Marshal(playlist)
+	if err != nil {
+		return fmt.Errorf("could not serialise Playlist: %v", err)
+	}
+	return tx.Bucket(bucketPlaylists).Put([]byte(playlist.ID), value)
+}
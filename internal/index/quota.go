@@ -0,0 +1,63 @@
+package index
+
+import (
+	"strconv"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// AddQuota adds units to the API quota spent on the given day and returns the total.
+func (st *Index) AddQuota(day string, units int) (total int, err error) {
+	err = st.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketQuota)
+
+		total, err = quotaValue(b, day)
+		if err != nil {
+			return err
+		}
+		total += units
+
+		return b.Put([]byte(day), []byte(strconv.Itoa(total)))
+	})
+	return total, err
+}
+
+// SpendQuota adds units to the API quota spent on the given day unless the total
+// would exceed the limit. The check and the update are done in one transaction,
+// so concurrent callers cannot overspend. It returns the resulting total and
+// whether the units have been added.
+func (st *Index) SpendQuota(day string, units, limit int) (total int, ok bool, err error) {
+	err = st.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(bucketQuota)
+
+		total, err = quotaValue(b, day)
+		if err != nil {
+			return err
+		}
+		if total+units > limit {
+			return nil
+		}
+		total += units
+		ok = true
+
+		return b.Put([]byte(day), []byte(strconv.Itoa(total)))
+	})
+	return total, ok, err
+}
+
+// Quota returns the API quota spent on the given day.
+func (st *Index) Quota(day string) (total int, err error) {
+	err = st.db.View(func(tx *bolt.Tx) error {
+		total, err = quotaValue(tx.Bucket(bucketQuota), day)
+		return err
+	})
+	return total, err
+}
+
+func quotaValue(b *bolt.Bucket, day string) (int, error) {
+	v := b.Get([]byte(day))
+	if v == nil {
+		return 0, nil
+	}
+	return strconv.Atoi(string(v))
+}
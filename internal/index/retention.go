@@ -0,0 +1,49 @@
+package index
+
+import (
+	bolt "go.etcd.io/bbolt"
+)
+
+// BeginRetire marks a downloaded video as retiring and returns it, so that its
+// files can be removed outside of a transaction. The video is read in the same
+// transaction, so check and the caller get all storages currently listed for it.
+// If check fails, the video is kept. It returns nil if the video is no longer downloaded.
+func (st *Index) BeginRetire(id, reason string, check func(*Video) error) (video *Video, err error) {
+	err = st.db.Update(func(tx *bolt.Tx) error {
+		video, err = getByID(tx, []byte(id))
+		if err != nil || video == nil || video.Status != StatusDone {
+			video = nil
+			return err
+		}
+
+		if err := check(video); err != nil {
+			video = nil
+			return err
+		}
+
+		video.Status = StatusRetiring
+		video.Reason = reason
+
+		_, err = put(tx, video, true)
+		return err
+	})
+	return video, err
+}
+
+// Retire marks a retiring video as skipped once its files have been removed.
+// Sources are kept, so the video is not downloaded again when it is found again.
+func (st *Index) Retire(id string) error {
+	return st.db.Update(func(tx *bolt.Tx) error {
+		video, err := getByID(tx, []byte(id))
+		if err != nil || video == nil || video.Status != StatusRetiring {
+			return err
+		}
+
+		video.Status = StatusSkipped
+		video.Files = nil
+		video.Storages = nil
+
+		_, err = put(tx, video, true)
+		return err
+	})
+}
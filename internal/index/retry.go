@@ -0,0 +1,60 @@
+package index
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// maxAttemptHistory is the number of attempts kept in Video.Attempts.
+const maxAttemptHistory = 20
+
+// Attempt is a failed download attempt.
+type Attempt struct {
+	Time   time.Time `json:"time"`
+	Error  string    `json:"error,omitempty"`
+	Reason string    `json:"reason,omitempty"`
+}
+
+// RetryPolicy defines how failed downloads are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the number of retries before giving up, zero means no limit.
+	MaxAttempts int `yaml:"max_attempts"`
+	// Delay is the delay before the first retry, it doubles with every next attempt.
+	Delay time.Duration `yaml:"delay"`
+	// MaxDelay caps the delay between retries, zero means no cap.
+	MaxDelay time.Duration `yaml:"max_delay"`
+	// Jitter randomises delays by the given fraction, e.g. 0.2 is ±20%.
+	Jitter float64 `yaml:"jitter"`
+	// Horizon is the time since the first failure after which retries stop, zero means no limit.
+	Horizon time.Duration `yaml:"horizon"`
+}
+
+// Backoff returns the delay before the n-th retry, starting from 1.
+func (p RetryPolicy) Backoff(n int) time.Duration {
+	if n < 1 || p.Delay <= 0 {
+		return 0
+	}
+
+	delay := float64(p.Delay) * math.Pow(2, float64(n-1))
+	if p.Jitter > 0 {
+		delay *= 1 + p.Jitter*(2*rand.Float64()-1)
+	}
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+
+	return time.Duration(delay)
+}
+
+// Exhausted reports whether the n-th retry of a video failing since a given time
+// must not happen.
+func (p RetryPolicy) Exhausted(n int, since, now time.Time) bool {
+	if p.MaxAttempts > 0 && n > p.MaxAttempts {
+		return true
+	}
+	if p.Horizon > 0 && now.Sub(since) > p.Horizon {
+		return true
+	}
+	return false
+}
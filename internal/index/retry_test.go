@@ -0,0 +1,67 @@
+package index_test
+
+import (
+	"testing"
+	"time"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+func TestBackoff(t *testing.T) {
+	p := index.RetryPolicy{Delay: time.Minute, MaxDelay: 10 * time.Minute}
+
+	cases := map[int]time.Duration{
+		0: 0,
+		1: time.Minute,
+		2: 2 * time.Minute,
+		4: 8 * time.Minute,
+		5: 10 * time.Minute,
+		9: 10 * time.Minute,
+	}
+	for n, expected := range cases {
+		if d := p.Backoff(n); d != expected {
+			t.Errorf("Backoff(%d) = %s, expected %s", n, d, expected)
+		}
+	}
+}
+
+func TestBackoffJitter(t *testing.T) {
+	p := index.RetryPolicy{Delay: time.Minute, Jitter: 0.5}
+
+	for i := 0; i < 100; i++ {
+		d := p.Backoff(2)
+		if d < time.Minute || d > 3*time.Minute {
+			t.Fatalf("Backoff(2) = %s, expected between 1m and 3m", d)
+		}
+	}
+}
+
+func TestBackoffJitterCapped(t *testing.T) {
+	p := index.RetryPolicy{Delay: time.Minute, MaxDelay: 10 * time.Minute, Jitter: 0.5}
+
+	for i := 0; i < 100; i++ {
+		if d := p.Backoff(9); d > 10*time.Minute {
+			t.Fatalf("Backoff(9) = %s, expected at most 10m", d)
+		}
+	}
+}
+
+func TestExhausted(t *testing.T) {
+	now := time.Now()
+
+	p := index.RetryPolicy{MaxAttempts: 3, Horizon: time.Hour}
+	if p.Exhausted(3, now.Add(-time.Minute), now) {
+		t.Error("expected 3rd attempt to be allowed")
+	}
+	if !p.Exhausted(4, now.Add(-time.Minute), now) {
+		t.Error("expected 4th attempt to be rejected")
+	}
+	if !p.Exhausted(1, now.Add(-2*time.Hour), now) {
+		t.Error("expected attempt past the horizon to be rejected")
+	}
+
+	unlimited := index.RetryPolicy{}
+	if unlimited.Exhausted(1000, now.Add(-1000*time.Hour), now) {
+		t.Error("expected unlimited policy to never be exhausted")
+	}
+}
@@ -0,0 +1,83 @@
+package index
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SourceKind is a way videos get into the index.
+type SourceKind string
+
+const (
+	SourceHistory  SourceKind = "history"
+	SourcePlaylist SourceKind = "playlist"
+	SourceChannel  SourceKind = "channel"
+	SourceImport   SourceKind = "import"
+	SourceManual   SourceKind = "manual"
+)
+
+var SourceKinds = []SourceKind{SourceHistory, SourcePlaylist, SourceChannel, SourceImport, SourceManual}
+
+// Source records where a video has been found.
+type Source struct {
+	Kind SourceKind `json:"kind"`
+	// ID is the ID of the playlist or the channel.
+	ID        string    `json:"id,omitempty"`
+	FirstSeen time.Time `json:"first_seen"`
+}
+
+// ParseSource parses a source in the `kind[:id]` form.
+func ParseSource(s string) (Source, error) {
+	var src Source
+	kind, id := s, ""
+	if i := strings.IndexByte(s, ':'); i >= 0 {
+		kind, id = s[:i], s[i+1:]
+	}
+	src.Kind, src.ID = SourceKind(kind), id
+
+	for _, k := range SourceKinds {
+		if src.Kind == k {
+			if id != "" && k != SourcePlaylist && k != SourceChannel {
+				return src, fmt.Errorf("source %q does not accept an ID", kind)
+			}
+			return src, nil
+		}
+	}
+	return src, fmt.Errorf("unknown source %q, expected one of %v", kind, SourceKinds)
+}
+
+func (s Source) String() string {
+	if s.ID == "" {
+		return string(s.Kind)
+	}
+	return string(s.Kind) + ":" + s.ID
+}
+
+// Matches reports whether the source matches a pattern. A pattern without
+// an ID matches all sources of its kind.
+func (s Source) Matches(pattern Source) bool {
+	return s.Kind == pattern.Kind && (pattern.ID == "" || s.ID == pattern.ID)
+}
+
+// AddSource records a source of the video unless it is already known.
+// It returns false if the video has not been changed.
+func (v *Video) AddSource(src Source) bool {
+	for _, s := range v.Sources {
+		if s.Kind == src.Kind && s.ID == src.ID {
+			return false
+		}
+	}
+	v.Sources = append(v.Sources, src)
+	return true
+}
+
+// HasSource reports whether any source of the video matches the pattern.
+func (v *Video) HasSource(pattern Source) bool {
+	for _, s := range v.Sources {
+		if s.Matches(pattern) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package index_test
+
+import (
+	"testing"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+func TestParseSource(t *testing.T) {
+	cases := map[string]index.Source{
+		"history":        {Kind: index.SourceHistory},
+		"playlist":       {Kind: index.SourcePlaylist},
+		"playlist:PL123": {Kind: index.SourcePlaylist, ID: "PL123"},
+		"channel:UC123":  {Kind: index.SourceChannel, ID: "UC123"},
+	}
+	for s, expected := range cases {
+		src, err := index.ParseSource(s)
+		if err != nil {
+			t.Errorf("ParseSource(%q): unexpected error: %v", s, err)
+			continue
+		}
+		if src != expected || src.String() != s {
+			t.Errorf("ParseSource(%q): expected %+v, got %+v", s, expected, src)
+		}
+	}
+
+	for _, s := range []string{"", "unknown", "manual:x", "history:x"} {
+		if _, err := index.ParseSource(s); err == nil {
+			t.Errorf("ParseSource(%q): expected an error", s)
+		}
+	}
+}
+
+func TestSources(t *testing.T) {
+	video := &index.Video{}
+	if !video.AddSource(index.Source{Kind: index.SourcePlaylist, ID: "PL1"}) {
+		t.Error("expected a new source to be added")
+	}
+	if video.AddSource(index.Source{Kind: index.SourcePlaylist, ID: "PL1"}) {
+		t.Error("expected a known source to be skipped")
+	}
+	video.AddSource(index.Source{Kind: index.SourceManual})
+
+	for pattern, expected := range map[string]bool{
+		"playlist":     true,
+		"playlist:PL1": true,
+		"playlist:PL2": false,
+		"manual":       true,
+		"history":      false,
+	} {
+		src, _ := index.ParseSource(pattern)
+		if video.HasSource(src) != expected {
+			t.Errorf("HasSource(%q): expected %v", pattern, expected)
+		}
+	}
+}
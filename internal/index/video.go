@@ -2,9 +2,26 @@ package index
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 	"time"
 )
 
+const infoJSONSuffix = ".info.json"
+
+// MediaType is the kind of the downloaded media file.
+type MediaType string
+
+const (
+	MediaVideo MediaType = "video"
+	MediaAudio MediaType = "audio"
+)
+
+var mediaExtensions = map[string]MediaType{
+	".mp4": MediaVideo, ".mkv": MediaVideo, ".webm": MediaVideo, ".flv": MediaVideo, ".3gp": MediaVideo,
+	".m4a": MediaAudio, ".opus": MediaAudio, ".ogg": MediaAudio, ".mp3": MediaAudio, ".aac": MediaAudio, ".flac": MediaAudio,
+}
+
 type Status string
 
 const (
@@ -14,6 +31,8 @@ const (
 	StatusInProgress Status = "INPROGRESS"
 	StatusDone       Status = "DONE"
 	StatusFailed     Status = "FAILED"
+	StatusScheduled  Status = "SCHEDULED"
+	StatusRetiring   Status = "RETIRING"
 	StatusAny        Status = ""
 )
 
@@ -27,6 +46,27 @@ type Video struct {
 	RetryAfter *time.Time `json:"retry_after,omitempty"`
 	Meta       *Meta      `json:"meta,omitempty"`
 	Reason     string     `json:"reason,omitempty"`
+	Attempts   []Attempt  `json:"attempts,omitempty"`
+	// Profile is the name of the download profile the video has been downloaded with.
+	Profile string `json:"profile,omitempty"`
+	// Sources are the ways the video has been found, in order of discovery.
+	Sources []Source `json:"sources,omitempty"`
+	// ScheduledAt is the time a scheduled video is checked again.
+	ScheduledAt *time.Time `json:"scheduled_at,omitempty"`
+	// CheckedAt is the time the availability of a downloaded video was last checked.
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+	// Unavailable is set when a downloaded video disappears from Youtube.
+	Unavailable *Unavailability `json:"unavailable,omitempty"`
+	// RefreshedAt is the time the metadata of a downloaded video was last fetched again.
+	RefreshedAt *time.Time `json:"refreshed_at,omitempty"`
+	// CommentsAt is the last time comments of the video have been archived.
+	CommentsAt *time.Time `json:"comments_at,omitempty"`
+}
+
+// Unavailability records when and why a video became unavailable on Youtube.
+type Unavailability struct {
+	Since  time.Time `json:"since"`
+	Reason string    `json:"reason"`
 }
 
 func (v *Video) Key() []byte {
@@ -37,12 +77,92 @@ func (v *Video) StatusKey() []byte {
 	return []byte(fmt.Sprintf("%s::%s", v.Status, v.ID))
 }
 
+// AddAttempt records a failed attempt. The history keeps the first attempt
+// and the most recent ones.
+func (v *Video) AddAttempt(a Attempt) {
+	v.Attempts = append(v.Attempts, a)
+	if n := len(v.Attempts); n > maxAttemptHistory {
+		v.Attempts = append(v.Attempts[:1], v.Attempts[n-maxAttemptHistory+1:]...)
+	}
+}
+
 func (v *Video) ClearSystem() {
 	v.RetryAfter = nil
 	v.Attempt = 0
 	v.Deadline = nil
 }
 
+// Rescued reports whether the video has been backed up and is no longer available on Youtube.
+func (v *Video) Rescued() bool {
+	return v.Status == StatusDone && v.Unavailable != nil
+}
+
+// HasStorage reports whether the video has a copy on the storage.
+func (v *Video) HasStorage(id string) bool {
+	for _, st := range v.Storages {
+		if st.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Size returns the total size of the video files.
+func (v *Video) Size() uint64 {
+	var size uint64
+	for _, f := range v.Files {
+		size += f.Size
+	}
+	return size
+}
+
+// Stem returns the path of the video files without extensions,
+// as determined by the info.json file written by youtube-dl.
+func (v *Video) Stem() (string, bool) {
+	for _, f := range v.Files {
+		if strings.HasSuffix(f.Path, infoJSONSuffix) {
+			return strings.TrimSuffix(f.Path, infoJSONSuffix), true
+		}
+	}
+	return "", false
+}
+
+// Media returns the largest audio or video file of the video.
+func (v *Video) Media() (File, bool) {
+	var media File
+	found := false
+	for _, f := range v.Files {
+		if _, ok := mediaExtensions[strings.ToLower(filepath.Ext(f.Path))]; !ok {
+			continue
+		}
+		if !found || f.Size > media.Size {
+			media, found = f, true
+		}
+	}
+	return media, found
+}
+
+// MediaType returns the type of the media file of the video, or an empty string
+// if the video has not been downloaded.
+func (v *Video) MediaType() MediaType {
+	media, ok := v.Media()
+	if !ok {
+		return ""
+	}
+	return mediaExtensions[strings.ToLower(filepath.Ext(media.Path))]
+}
+
+// SetFile adds a file to the video or replaces the one with the same path.
+func (v *Video) SetFile(file File) {
+	for i, f := range v.Files {
+		if f.Path == file.Path {
+			v.Files[i] = file
+			return
+		}
+	}
+	v.Files = append(v.Files, file)
+}
+
 type Storage struct {
 	ID string `json:"id"`
 }
@@ -60,4 +180,49 @@ type Meta struct {
 	ChannelTitle string    `json:"channel_title,omitempty"`
 	Tags         []string  `json:"tags,omitempty"`
 	PublishedAt  time.Time `json:"published_at,omitempty"`
+	// Duration is the length of the video in seconds.
+	Duration   int         `json:"duration,omitempty"`
+	Category   string      `json:"category,omitempty"`
+	Language   string      `json:"language,omitempty"`
+	License    string      `json:"license,omitempty"`
+	Captions   bool        `json:"captions,omitempty"`
+	Thumbnails []Thumbnail `json:"thumbnails,omitempty"`
+	Statistics *Statistics `json:"statistics,omitempty"`
+	// FetchedAt is the time this version of metadata was first seen.
+	FetchedAt time.Time `json:"fetched_at,omitempty"`
+}
+
+// Licenses of videos as reported by Youtube API.
+const (
+	LicenseYoutube        = "youtube"
+	LicenseCreativeCommon = "creativeCommon"
+)
+
+type Thumbnail struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width,omitempty"`
+	Height int    `json:"height,omitempty"`
+}
+
+// Statistics are counters of a video at the time its metadata was fetched.
+type Statistics struct {
+	Views    uint64 `json:"views"`
+	Likes    uint64 `json:"likes"`
+	Comments uint64 `json:"comments"`
+}
+
+// Edited reports whether the creator-editable fields differ from another version.
+func (m *Meta) Edited(other *Meta) bool {
+	if m.Title != other.Title || m.Description != other.Description || m.ChannelTitle != other.ChannelTitle {
+		return true
+	}
+	if len(m.Tags) != len(other.Tags) {
+		return true
+	}
+	for i := range m.Tags {
+		if m.Tags[i] != other.Tags[i] {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,39 @@
+package index_test
+
+import (
+	"testing"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+func TestMedia(t *testing.T) {
+	video := &index.Video{
+		Files: []index.File{
+			{Path: "abc/abc.info.json", Size: 100},
+			{Path: "abc/abc.f137.mp4", Size: 10},
+			{Path: "abc/abc.MKV", Size: 50},
+			{Path: "abc/abc.jpg", Size: 1000},
+		},
+	}
+
+	media, ok := video.Media()
+	if !ok || media.Path != "abc/abc.MKV" {
+		t.Errorf("unexpected media file: %+v (%v)", media, ok)
+	}
+	if mt := video.MediaType(); mt != index.MediaVideo {
+		t.Errorf("unexpected media type: %q", mt)
+	}
+
+	video.Files[2].Path = "abc/abc.opus"
+	if mt := video.MediaType(); mt != index.MediaAudio {
+		t.Errorf("unexpected media type: %q", mt)
+	}
+
+	video.Files = video.Files[:1]
+	if _, ok := video.Media(); ok {
+		t.Error("expected no media file")
+	}
+	if mt := video.MediaType(); mt != "" {
+		t.Errorf("expected no media type, got %q", mt)
+	}
+}
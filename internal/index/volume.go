@@ -0,0 +1,48 @@
+package index
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Volume is a storage volume that has been seen by ytbackup.
+type Volume struct {
+	ID       string    `json:"id"`
+	Path     string    `json:"path"`
+	LastSeen time.Time `json:"last_seen"`
+	Total    uint64    `json:"total,omitempty"`
+	Free     uint64    `json:"free,omitempty"`
+}
+
+func (st *Index) PutVolume(volume *Volume) error {
+	return st.db.Update(func(tx *bolt.Tx) error {
+		value, err := json.Marshal(volume)
+		if err != nil {
+			return fmt.Errorf("could not serialise Volume: %v", err)
+		}
+		return tx.Bucket(bucketVolumes).Put([]byte(volume.ID), value)
+	})
+}
+
+func (st *Index) Volumes() ([]*Volume, error) {
+	volumes := make([]*Volume, 0)
+
+	err := st.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucketVolumes).ForEach(func(k, v []byte) error {
+			var volume Volume
+			if err := json.Unmarshal(v, &volume); err != nil {
+				return fmt.Errorf("could not parse volume %s: %v", k, err)
+			}
+			volumes = append(volumes, &volume)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return volumes, nil
+}
@@ -0,0 +1,233 @@
+// Package layout builds paths of downloaded videos from templates.
+//
+// A template is a slash-separated path without an extension, where
+// placeholders in braces are replaced with video metadata:
+//
+//	{id}                 video ID
+//	{title}              video title
+//	{channel}            channel title
+//	{channel_id}         channel ID
+//	{published}          publication date, 2006-01-02
+//	{published:FORMAT}   publication date in Go time format, e.g. {published:2006}
+//
+// The last element is the name of the video files, youtube-dl appends
+// extensions to it. Substituted values are sanitised to be valid file names.
+package layout
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+// maxValueLength limits the length of a substituted value in bytes.
+const maxValueLength = 100
+
+type part struct {
+	literal string
+	field   string
+	format  string
+}
+
+// Layout is a parsed path template.
+type Layout struct {
+	tmpl  string
+	parts []part
+}
+
+// Parse parses a path template.
+func Parse(tmpl string) (*Layout, error) {
+	l := &Layout{tmpl: tmpl}
+	hasID := false
+
+	for s := tmpl; s != ""; {
+		i := strings.IndexAny(s, "{}")
+		if i < 0 {
+			l.parts = append(l.parts, part{literal: s})
+			break
+		}
+		if s[i] == '}' {
+			return nil, fmt.Errorf("unexpected `}` in layout %q", tmpl)
+		}
+		if i > 0 {
+			l.parts = append(l.parts, part{literal: s[:i]})
+		}
+
+		j := strings.IndexByte(s[i:], '}')
+		if j < 0 {
+			return nil, fmt.Errorf("unclosed `{` in layout %q", tmpl)
+		}
+		p := part{field: s[i+1 : i+j]}
+		if k := strings.IndexByte(p.field, ':'); k >= 0 {
+			p.field, p.format = p.field[:k], p.field[k+1:]
+		}
+
+		switch p.field {
+		case "published":
+			if p.format == "" {
+				p.format = "2006-01-02"
+			}
+		case "id", "title", "channel", "channel_id":
+			if p.format != "" {
+				return nil, fmt.Errorf("field {%s} does not accept a format", p.field)
+			}
+		default:
+			return nil, fmt.Errorf("unknown field {%s} in layout %q", p.field, tmpl)
+		}
+		hasID = hasID || p.field == "id"
+
+		l.parts = append(l.parts, p)
+		s = s[i+j+1:]
+	}
+
+	if !hasID {
+		return nil, errors.New("layout must contain {id}")
+	}
+	if strings.HasPrefix(tmpl, "/") || strings.HasSuffix(tmpl, "/") {
+		return nil, fmt.Errorf("layout %q must be a relative path to a file", tmpl)
+	}
+	for _, elem := range strings.Split(tmpl, "/") {
+		if elem == "" || elem == "." || elem == ".." {
+			return nil, fmt.Errorf("layout %q contains an invalid path element %q", tmpl, elem)
+		}
+	}
+
+	return l, nil
+}
+
+// MustParse is like Parse but panics on errors.
+func MustParse(tmpl string) *Layout {
+	l, err := Parse(tmpl)
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+func (l *Layout) String() string {
+	return l.tmpl
+}
+
+func (l *Layout) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	*l = *parsed
+	return nil
+}
+
+// Path returns the path of the video files relative to the storage root, without an extension.
+func (l *Layout) Path(id string, meta *index.Meta) string {
+	if meta == nil {
+		meta = &index.Meta{}
+	}
+
+	var b strings.Builder
+	for _, p := range l.parts {
+		switch p.field {
+		case "":
+			b.WriteString(p.literal)
+		case "id":
+			b.WriteString(value(id))
+		case "title":
+			b.WriteString(value(meta.Title))
+		case "channel":
+			b.WriteString(value(meta.ChannelTitle))
+		case "channel_id":
+			b.WriteString(value(meta.ChannelID))
+		case "published":
+			b.WriteString(value(formatTime(meta.PublishedAt, p.format)))
+		}
+	}
+
+	elems := strings.Split(b.String(), "/")
+	for i, elem := range elems {
+		elems[i] = trimName(elem)
+	}
+
+	return filepath.Join(elems...)
+}
+
+// ChannelDir returns the top-level directory of the layout if it is
+// specific to a channel, i.e. built only from channel fields.
+func (l *Layout) ChannelDir(meta *index.Meta) (string, bool) {
+	i := strings.Index(l.tmpl, "/")
+	if i < 0 {
+		return "", false
+	}
+
+	dir, err := Parse(l.tmpl[:i] + "/{id}")
+	if err != nil {
+		return "", false
+	}
+	channel := false
+	for _, p := range dir.parts[:len(dir.parts)-2] {
+		switch p.field {
+		case "channel", "channel_id":
+			channel = true
+		case "":
+		default:
+			return "", false
+		}
+	}
+	if !channel {
+		return "", false
+	}
+
+	return filepath.Dir(dir.Path("", meta)), true
+}
+
+func formatTime(t time.Time, format string) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(format)
+}
+
+// Sanitise makes a string usable as a file name on common file systems.
+func Sanitise(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case strings.ContainsRune(`/\:*?"<>|`, r):
+			return '_'
+		case unicode.IsControl(r) || unicode.IsSpace(r):
+			return ' '
+		case !unicode.IsPrint(r):
+			return -1
+		}
+		return r
+	}, s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func value(s string) string {
+	s = Sanitise(s)
+	if len(s) > maxValueLength {
+		n := maxValueLength
+		for n > 0 && !utf8.RuneStart(s[n]) {
+			n--
+		}
+		s = strings.TrimSpace(s[:n])
+	}
+	return s
+}
+
+// trimName removes leading and trailing dots and spaces that are problematic in file names.
+func trimName(s string) string {
+	s = strings.Trim(s, ". ")
+	if s == "" {
+		return "_"
+	}
+	return s
+}
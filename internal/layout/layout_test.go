@@ -0,0 +1,91 @@
+package layout_test
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/layout"
+)
+
+func TestPath(t *testing.T) {
+	meta := &index.Meta{
+		Title:        "What's new: Go 1.15?",
+		ChannelID:    "UC123",
+		ChannelTitle: "The Go/Programming Language",
+		PublishedAt:  time.Date(2020, 8, 11, 10, 0, 0, 0, time.UTC),
+	}
+
+	cases := map[string]string{
+		"{published:2006}/{published:01}/{published:20060102}_{id}/{id}": "2020/08/20200811_abc/abc",
+		"{channel}/{published} - {title} [{id}]":                         "The Go_Programming Language/2020-08-11 - What's new_ Go 1.15_ [abc]",
+		"{channel_id}/{id}":                                              "UC123/abc",
+	}
+	for tmpl, expected := range cases {
+		l, err := layout.Parse(tmpl)
+		if err != nil {
+			t.Errorf("Parse(%q): unexpected error: %v", tmpl, err)
+			continue
+		}
+		if p := l.Path("abc", meta); p != filepath.FromSlash(expected) {
+			t.Errorf("Path(%q): expected %q, got %q", tmpl, expected, p)
+		}
+	}
+}
+
+func TestPathSanitise(t *testing.T) {
+	l := layout.MustParse("{channel}/{title} [{id}]")
+
+	meta := &index.Meta{Title: strings.Repeat("ж", 100), ChannelTitle: ".."}
+	p := l.Path("abc", meta)
+
+	elems := strings.Split(p, string(filepath.Separator))
+	if len(elems) != 2 || elems[0] != "_" {
+		t.Fatalf("unexpected path: %q", p)
+	}
+	if !strings.HasSuffix(elems[1], " [abc]") || len(elems[1]) > 110 {
+		t.Errorf("unexpected file name: %q", elems[1])
+	}
+
+	if p := l.Path("abc", nil); p != filepath.FromSlash("_/[abc]") {
+		t.Errorf("unexpected path without metadata: %q", p)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tmpl := range []string{
+		"{title}",
+		"{id",
+		"id}",
+		"{unknown}/{id}",
+		"{id:x}",
+		"/{id}",
+		"{id}/",
+		"a//{id}",
+		"../{id}",
+	} {
+		if _, err := layout.Parse(tmpl); err == nil {
+			t.Errorf("Parse(%q): expected an error", tmpl)
+		}
+	}
+}
+
+func TestChannelDir(t *testing.T) {
+	meta := &index.Meta{ChannelID: "UC123", ChannelTitle: "Channel: Title"}
+
+	cases := map[string]string{
+		"{channel}/{published} - {title} [{id}]": "Channel_ Title",
+		"{channel} ({channel_id})/{id}/{id}":     "Channel_ Title (UC123)",
+		"{published:2006}/{id}":                  "",
+		"{channel} {title}/{id}":                 "",
+		"{id}":                                   "",
+	}
+	for tmpl, expected := range cases {
+		dir, ok := layout.MustParse(tmpl).ChannelDir(meta)
+		if ok != (expected != "") || dir != expected {
+			t.Errorf("ChannelDir(%q): expected %q, got %q (%v)", tmpl, expected, dir, ok)
+		}
+	}
+}
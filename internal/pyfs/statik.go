@@ -9,6 +9,6 @@ import (
 const Python = "python" // static asset namespace
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x00dl.pyUT\x05\x00\x01\x80Cm8\xb4Ymo\xe4\xb6\x11\xfe\xae_\xc1\xf2`@\xba\xc8:\xdf\xa1I\x8b\x05T4\xcd9/\x80\x9b;\x9c\x1d\xa0\x81c\x08\\i\xb4\xcbX\"\x15\x92Z\xef\xc6\xf0\x7f/\x86\xa4$J\xbb\x9b4@\xaa\x0f^\x89\x9c\x19>\x1c\xce\x1b\xc7\xaf\xfe\xf2\xa6\xd7\xea\xcd\x9a\x8b7 v\xa4;\x98\xad\x14Q\xc4\xdbN*C\x98\xdatLi\x18\xbeK)\x0c\xecM\xc3\xd7\xd3Hw\x18\xde7\x8d\x1c\xc7\xb7Lo\x03\xb2\xad1]V6\x1c\x84\x19\x86~\xd6R\x0c\xef\x8d\xdcl\xb8\xd8\x0c\x9fR\x0foz\xdb\x1b\xde\x8c_\x86\x8d\xec\xfa0\x12\x99C\x170\xf7\xaai\xf8:\x03\xa5\xa4\x8aj%[\xd2\x0bn\x0chC<E+\xcb\xc7(\xba\xfd\xf1\xf6\xee\xfa\xdf\xc5\xf5\x7f\xbe\xba%9\x89C\xb6\xec\x87O7\xd7\xf8\x92\x86\xc0\xb3o\xef\xee>^\xefK\xe8\x0c\x97\"%\x1fn-M\x12E\xb7w\xef\xaf?}\"9\x82\xca\xb4\xa9@\xa9(\xfa\xf1\xfdM\xf1\xe1\xe3\xddw\x1f\xbeG\xf9\xcf\x11!\x84\xd0u_\xd7\xa04\xff\x15\xe8\x8a\xbc\xfd\x82\xbc&o\xaf\xde\xfd5u\x93\n\x8c\xe2\xa0\xe9\x8a|\xeeGj\xc56-\x08S\x1cO\xfd\xd2s0tE\xeeT\x0f~H\xc8N\xc9\x8d\x02\xad\xe7\xe3\x07\xd9\x9b~\x0d\x05\x17e\xd3WPTLo\x8b\x96	^\x83>\x12Q\x94\xb2\x91j>Z\xb2\xa6)\xb6\xb2E\xd0_\xb3F\x0f\xe3|#\xa4\x02\xab2\xbd\x98\xda\x80,\xd6\x87\x8e-\xb1\xec@\xad\xa5^\n\xea\x14\xd4\xa0\x8a\xban;\xd8\x1cm\xaaa\x87\x86/\x91>)n\xa0@`f\xdb\xb7k\xc1x\xb3X\x8a5\x8d\xee\xd7\x86\x9b\x06\xf4	\xde\xdf\x9a\xe3\xa2\x96h\x9f\xf3\xa9Z\xaa\x96!\x0c\xba\x06mv\xbc\x02\xf9\x19\xbe\xb1\xbe\xe2\xf2\x0d\xbeQO\xd9\x82\xda@!{\xd3\xf5\xa6\x98\xd8\xda\xc7\x1dM\xa3\x97(\x8a^\x91\xcb?\xf5\x89\xa2\xa8l\x98\xd6\xc4\x9ad<Zi\xb2\xb2\x80*\xa8IQp\xc1MQ\xc4\x1a\x9a:%\xaf\x99\xda\xe8\x94(`Z\x8a\xfc{) %\xaf_?>\xe1\xb0\xe7\xc2\x07\x893GDrOM\xa4\"\xb4\x17\x8fB>	:R\xbe\"Br\xa1;(qa\xf2\xf1\xf0\xa5\xda\xf4h\xbc7\\\x9bI^\xdf\x81\x8a\x93lD\xe3q\x8cKGQ\x84hQ\xfbE\xd5\xb7]\\1\xc3RR\xaf\xbc\x9bgw\xb07\xdf}\xf0\x10\x91,\xb3d\xe3\n\x9e>%\\T L\xfe.%\xfa\x91w\x8fp\xd0\xb9=L\x02B\xf7\n\n\xa6K\xcesg\x86\xa8!\xd67&oX\xbb\xae\x18\xd9\xaf\x88U\x89\x95\x9a\xd8\xbfufM#\xa6?	\x8a(\xff9\x05\xc3\xcc\xbf\xb6L\xb0\x0d(\xbb\x01\xddw\x1d\xba\xa2\xb7\x82\xd8\x03~\xe2fKd\x07\"\x96:\xab`'\xfa\xa6I	}\xa2	a\x9a\xd4\x93\xe2-a\xb0\x84\x82\x8a+(M\xa1M%{\x13\xd7Izn\x1a\x94\x8a\xeb\xe0\x0c\xf19ph*\xaf\xdb\x0d\x98\x02#.\xa8\xb8\xe6\x0d\x08\xd6\xc2\xa8\xdd\x0f\xd6lXs\xaf\x8dz \xb9\xd5BB.\xff1\x84\xe8\xec\xc62:\xe1N\x08\xc9\xc7\xc9\x0d\x187\x1f\xd3Fnh\x12Pe\x1a\xcc\x0d\xec\xa0\x89\x07\xe2\xf7\xd7\xff\xfa\xe1\x9b$\xb24\xbc&B\x9a\x81t\xcbD\xd5\x80\xd2\x81\x19\x1a\x05\xac%9q\xa1v\x1c\xe75\x19\xb70\xdb\xef\xc8`u=\xd0\xa4\x842\xea\x97\xc4\xc7/\x14\xec\xe0\xd6\xf2}\xeb\xc6c'%`\xa8[\x13\x10\x7fm=\xdb\xe0v/b\xa6K\xc3[H\xf4O\xe6\"np\xa7\xb8\xa2\xfblAk\xb6\x81D{\x95\x04k\xa3^&9ukNR\x9c\xd7\x1c\x994\xcc\xaaj\x00\xeeY=\x8d\x02\xd3+\xe1\xc9\xbc\x11\x94\n\x98\x81b\xc8\x18\xc5V\xca\xc7\xd8Q\x04A\xa3\x91\x1b7\x83^\x15\x86\x05\xfe+\x14\x95\x14@r\xebpx\xf21\xad\xe4\x93h$\xab\xa0*\xd6\x07\x03\x9a\xa6\xce~\xe6lF\x1a\xd6\xcc\xf8\xec\xc8\x82e\xe4Q`\x93\xf6\x90B\x87\x87\xd6\\p\xbd\x85\x8a\xae\x02IX!\xf4\x9a&$\xcf\x03\x8at\xce\x89\xb81\x1c\xf7\xe21\x98z\x89B\xab\x9a6\xc8\xb55MDE\x98\xa8\xc2=\x04Ss\xebs\x98\xef\x03\x85Pt\xa6Q\xe8Ib+s\xa2\xb3\x9fg\xa4\n\xb0t\xf4\"{W_\\PrA\xe2	0\xd6\x14W\xe4M \xe5\xd8T0\xc1\xc5\xb4\x98\x8e\xbf \x17\xa8\xfb1\x9e\xea\xd8\xad\x96\x1c\x99\x90\xb5\x07\x9b\xc2\x16\xf1\xfe\x07\xa1@\xcbf\x07\xd5'\xcc\xe6 J\xd0.\x14n\xd9\xbb\xcf\xbf\xd0}\x1b\x84\x1bmT\xea\xc1\xac\x16\xb1\xc5\x86\x1bm|\x8c\xd9\x92|(&3'(v\xf6\xb4&9A\x93aJ\xb1C\xfc\xf6\xdd\xdf}1\xe5f\xdb\x1d\xc9I\x0b\xadT\x87\x1d\x87\xa7x\xed\x86\x07\xdb\xbb\xb2_S0\x0e\x02\x84Z\xd3\x94\xb8:\x8d\x8bM~\xb5\x0c\xcc\xb5T\x84\xa7D\x10.\x08\x88\xbe\x05\xc5\x0c\xc4\x1c\xbd\xd7%\x8f\x15\xb1\x19\xb3\xe2\xc2\xc8\xb8\xdd%)\xb9J\x16\xd1\xd8\xc1\xf8,'b6\xec\xa3`\xcc\xc9\x05y\xfb\xc5\xd5\x82\x89,\x8f\xcf\xa9cE.*\x9a\x12\x7f\xd0!\xf16\xeb\xbb\n\xc1\xb5\xbb\xfb\x95xH\xc2\x83\xdcf[\xd8W|\x03\xda\xc4\xc9\xff\xb3 y\xef]\xe0l!\x82Y\x7f5\xde4\xb2\xefY\x0b\xbac%,\x8b\x90^\xe1\xc1!5\xbe\xce\xe7\xc6D\x14\xa46K\xd9\xc8M`\xfc\x7f\xd6&\xa3\xf9\xea\x15hST\x1c\xd7\x97:\xeb\x98\xd9fl\xad\xf17\x1e\xbea\xdf1Q\xf5z\xc0Ui\xf4\xacA\x8a\xd4Y\xcb\x1e1\x83\xeb\x91\xa3\xe2\n\x0d2\x9e\xad\x90\xa4\x04\xf6\\\x9bB>\xdaZ&Y QR\x9a?\x80\x02\xc9\x93\xa5\x0c_\xb5\xba\xfd\x98\xb6[\xec\xecg\xc9E<.\x96\x12\x9a\x99\xb6\xa3\xa7\xf7\xb2\x90w\x1e\xfc+\xf2\x15+\xb7`}\xcb\xdfV.\xab\xe9\x8cK\x9c\xf48,p;\x80e\xe8\x0c\x95\x07\x9b\x12z\xa8\x9a\xc2\xd2\x9c\x016\n\xfc-H\xbfg\x05\xff\xdb3I,{md[\xc8\xceh\x92\xbbH\x8b\x8ea\x8f\x1c\xc4\x8e+)\\\x12\xf37\xc7[\x9a\x12\xfa\xfcB\x03CaZ\x832\x84k.\xb4a\xa2\x848\x10\x9a\x92\x8a\x97&\xd8\x82_	\xaf\xe8\x19\xfe\x89\x83+i2\xa3\x1a\x02\xc5,|8?\xca\x03\x07\x9b\xe7Q\xd9\x1b\xd3vM~l\x19\xe1\x91\xd3\x8b\x98W\x89~\xe3~\xb2\x8b\x18\xf6\x06\xeb\xa0\xb9\xacY\x19\xa2\xf3\xfb\x93\xd5I\x80$y\x98\xf3\xdb\x03\xad\xb8\xca\xa7\x93\x1d\xe7\x93Yb\x1f\xc2\xc2<\xb6\xba\x9b'\x86\x0eL\xbf&\x88\x1e\x99\x82\xaea%\xc4\x14?\xf1H.\x1dq6U\xb8\xc3\x83\xaa\xbc\xa7\x9d\xd4\xa6S\xb2\x04\xad\xa5*P\x90M\xd6\xf7\xf4r\xbc\x9e\xa7X\x9c4\xb0z~\xa1\x99\xbb\x1e\xc6\x13\x82\xe4a\x0688\xe1E\x89;\xa9\xc3'\x84\xaf,i\xe0A\x88\x88K\xa1W.\xbb\x07\xa2\x8e\x81\x0f60#Z\x84\x06gP\xf8\x13\x8d\xe1\x1c\xf6P\xf6\xc6\x05*\x9b\xba\xfdM\xe2Kq\x98\xf0\xfa\xce\xcb\xd0\x89\xa8\x9aI\xf2\xa1\xc2\xb8>\xcdd?\xba\xd7\xf77\xf1\xb8\xe8\x84\xd6+\xb6\xc0\x0d#[\xd5d\xe1P\xa09QK\x04\xfb\x1c\xd4u\x08w\xa0>U\xd5\x92)\x07O%%\xaf\xe8\x89D\xec\xd2\xe8\x9c\x13W\xbcG\xc6{dzx\xf05nt\xcc7\xdb\x86C1\xa14\xea0_\xcf\x96)\xad,\x1f\xd1\xcf\xcam&\xd7?Ci\xe2C\x857\xc7P\x12Mg\xdb;\x01\x1b\xf55\x14\xa5\xf1\xfd\x90W\x1f&\xfd\x82\xed\x1c\x84\xc71$p\xdbY\xc0Z\x08\xf6\xe5\x91\xca`_f\xb0/-\x8a\xfb\xab\x07\xac\x8d\x82\xfe\xda	\xf51\xae\xc17+\xd0\xdd`_&c3\x82\xea\x836\xd0\xd2\x84\xd8\xf6\x1d\xec\xcb\xe8\x88w\xe6\"x`V\xfb\xabcB\xbf\x08U\xa0\xfb\x06C'\x81\xb63\x07:\xbba\xd8\xa9\x9c\xdc\x07\x9eg\xcb<42.\x9c\xecl\xc7\x9a\x1et\x9c,K}d>\x9b$\xc3Ph\x95cM#9erS\x96\xe6\xda`\xfd=\x08>e~'\xb6\xe6\x1a\x00R\x1d\x86{I-{QY\xd7\x1f\x82L\x80 \xd8?>\xae\xd1\x9a\xa9\xd6(X\x14\x1d)q}\xbe\xc25\xfa|\xa2<\xc1\xdc\xca\x1d\x04\xb0\xd3yu\xb4X\x10\xe3\x9f^\xe8\x9cx\xbd\xa3\x1aP\xef\x9bF\xae3\xfc\x13\x1f+vBG_\xbf\xa6\xd6x\xca^i\xbe\x03\x07\xf0Xg\xc7\xf1\xd2\x1d\x8e\x85\xe2#$.\x92\x1cq\x1ey\xe4\xf0\xd4\xdc\x1d:^;\xe3\xd3\xbc\xde\xa1|\xb3\xf8\xa4\xff\x0c\xcf\xecPK\xd97\x95=F\x14N\x02\xa8\xa1_\x1c\xc9\xc1\xab\xaba&\xbb-\xbe\xbb\xfdt\xfdM\\\xf3L\x9b\xa2\x95\xd5)\x8d\x90\xe1\x1c2\xd6u \xaa\xf8$\x05>\xcfgg\x88\xed\xdf2\xb3\xa5\xab\xd1\x82\x154\xb6\xee\xc4?\xe9T\x99.r\xfe\x91\x14\xbc\xe6\xd1Up]\x0c\xf8}\xda\xff\x1d	\xbe\xb1\xee6\x8d\x1f\xe7\xe9_N\xce,\x8c\xd4\x19\xf3\xa0\x9dg\xf4\x9cU\xe0\xc6>\x8dk\xbbd\x03\xfae\x16Tl\xa0w\x12|\xcf\xa5e\\\x0c\xf1\xc3\xdev|A\xebn>C\xbf\xf4\xa3\x9d\xf1\xb7\\G\x96\xb1\xaa\xc2J\xc2\xce\xc7\xf4\xf2r\xaa=\xce\x10\xa0\xbe):\xc5/=WP\x05N{\x86\xa1\xd2\x7f\x8c>,\xafO\x92\xf4\xaa\x19\x82,\xd6@$\x1f\xc8\xec\x8f\xad\x8b\xe2\xb0A\xf8\x9b\xb7\xb7\x99\x07\xda|x\xa6\xbf:?9\x92\x8f\xf7O+1\xc9\x86jer\xd4\xa9\xd3\xecx\xd2\xe1\xbf8\xb2\x1f\x8ai\xef\xc3cW\xfd\xc8\x8by\x1d\xd6\xe4\xb0/S\xe7\xc6\x0bP\xad\x1e\xcaK\xccw\x0b\xbc\xbe\xc5\x8e\x99\xd4}\x8c\xf3\xd0\xe8E\x7f\xc9G1\x18\x00\xc5C;\x9e\xd8\x18\xbd(K\xdd\xb2\xf4\xf9eE\x82\x82\x13\x17*\n{I/\x8a\xac(\xf0\xb6Y\x14\xe9\x08\xef\x0c\xbe\xa9\xf1\x7fB\x83\xcf\xd4\xad\xbf\xc25SB\x1d\x17]y\xf6\x974\xf8\x0fY\xd0\x15<hLz&\xbe\xda_\xff-\x89\xa2\x88\xd7d\xc0c\x1byE\x81\x9eS\x14\xd4i\xc1\xb9Q\xf4\xdf\x00\x00\x00\xff\xffPK\x07\x08\xee\x9c\x16_\xe4	\x00\x00\xbb\x1c\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x00ydl.pyUT\x05\x00\x01\x80Cm8\x94U\xddn\xe44\x14\xbe\xcfS\x1c\x99\x9b\x04F.\x8b\xc4\xcd\x88\x08\xad\xb6\x85\x16UPM[AUU\x96\xc7>iL\x13;\xd8\xced\x03\xe2\xdd\x91\x13O2\x93\xdd\x19\xb1s\xe59\x7f\xdfw~\xa3\xea\xc6X\x0f\xc6%\xf1\xe5\xdamc\x8d@7K\xfa\xe9\xe9\xb1n\nUa\x92\\\xfd\xf1\xb0y\xff\xe1\xe1\xb7\xcd=\xbb{\x82\x1c\x08!IaM\x0d\xb47\xado\xb7\x08\xd1%M\x00\x00\x9eF\xe1\xcd\xd5\xea\xf0\xef\x87\x92k\x8d\xd5B\xfa\x13\xdf\x99\xd6*\x8fn\xa1\xb8V\xce\x1b\xdb/\xa4\xb7j\xb7\x8c{W\xf1\xbeR\xce\x9f\x10/\xe3nP\x98\xbaF-Q.4\xf7\xc8\xad(/\xb9_\"\x8c\x8a\xcf\n\x1f7\xb7Kyi\xba\xa5\xa8\xdd:aU\xe3\x95\xd1K:\x0f\xb6\xd5\x82{\x947\x97\xa74\x9fb<:\xb4\x0b\xd1\xef\xdc\x8b\xf2\x96\xfbQ\x91%\xc9\xcf\xa8\xd1*qs\x05\xf9\xdc\x90$t.I$\x16P\xf37d\x95\xf2\x98f\xeb!\x10~\xf4\x96\x0bo,\x93\xcaB\x0e\xc6\xd1\x86\xfb\x92\xfei\x94NIl4\x93\x15Y\x01\x99lI\x96\x0c\xce\xc1a\x1e&j[=\x8eB\xf8=O\xaf\xf0s\xbd\xa3\xf8\x11E\xeb\xf9\xb6\xc2\xd5\x91\xee\x18R\xe2n,\x9b\x0b\x90#_\xfew\xcf&pG\x9b\x9edgB\x1c\xa5\xb4\x02r\xde\xfdexec>T\x94(\xde\x98E\xdfZ-\x8c\xc44f\xfa\x15h\xa3\xb4kP\x84v\xc2]\xff\xa8-:S\xedPn\xb0@\x8bZ\xa0\x1b,\x87\x0d\x99\xebF'\xe0\xfd\xb6D\xdd\x187l\x9ac\x16\xffj\x95E\x19\xca\x89>\xcd>\xa3\xa2\\\xca\xf4l\x9a\x8c)\xad<cC~\x91va,\xd4F\x82\xd2C\x07j#\xdb\n\xddzJ^\x15\xa0\x8d\x0f&\xd4yn\xbd\xeb\x94/\x0f\xdb>\xd3\xa7$[\x1f\xd5\\\x18\xed\x95nc\"\xff\x87\xf1\xd7\x03NS)\x9f\x12J\xb2\x0c\xbe\x012\xb0\x9d\xc9\x16*p5\x8e:\xc1\xb5T\xf68\xcb\xec\x88y\xa1\xa8r,\x80\xa6\x19p-\x83 \x80\x0d))\xbd\xa0\xb3^\x0e\x8c\xc5\xda\xec0\x8dN\x91C\xc8\x1fL\x83\xfa|\xad\x97\xd3\x04\xa4#\x19p\x07\xc5\x0cS\xd0.\\\xb8\xf4\xe8\x8cF\x98\x19\xfe\x8b'\xf7\xf4\xea=/\x96\x0cH/\xab\xe0\xb3\x02\xe2\xd1y\xf2rn\xca\x87\xf3\x10\xcc\xf6\x97\xe1x\\\x99\xacF\xe0^V\x90\x1f\x0ex<3\x97\xb7\xe9?DpQ\xa2T\x96\xac\xa7\xef\x08}E\x1f\xde\xa1\x99\xd9\xbf\xd9>\x06\x8d\xcc\x99\xd2\x85\x81\x1c*^o%\x07\xbe\x86_\x8d\xc6\xc9J\x9aNW\x86\xcb\xf4\x99\x94\xde7n}q\xd1u\xdd\xfe\x03D\x85\xa9/\xbap\x03\x7f\xdc\xe5\xe6\xfa\xf5\xfb\xfb_\x9e6\xd7\xef\xbf\x0d\x99&\x89*\x801\xcdkd\x0c\xf2<,H\xcd\x95f\x8c\xc4\xfc\n\xa8P\xa7\xa1h\xdc\xbe\xee2\xf8\x01\xbe\x9b\xbb\xd7X\xa5}J\xc2W#\x8c\x96r\xb0\x1f$\x92MFc\xc1\x95O\xdf\xc5\xae\x88z\xd8\xe1\x18\xf2\xf9\xdd\xcb\x1eiP\xe4\xfb{\xa6<\x92\x19\xea\xe0&\x0f2\xac\x0e\x1c\x86\xc6\xcd\xb6c\x83\xa2\x99\xc3O\xf8\xb6\xfaM\x9bNC\xe4}\x82\xeb\x7f\x01\x00\x00\xff\xffPK\x07\x08\xd2{C\xc6$\x03\x00\x00\x12\x08\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xee\x9c\x16_\xe4	\x00\x00\xbb\x1c\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xed\x81\x00\x00\x00\x00dl.pyUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd2{C\xc6$\x03\x00\x00\x12\x08\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81 \n\x00\x00ydl.pyUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x02\x00\x02\x00y\x00\x00\x00\x81\x0d\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x00	\x00dl.pyUT\x05\x00\x01\x80Cm8\xcc:\xfd\x8f\xdb8v\xbf\xfb\xafx\xd5b\x009\xabQ>p\xb7m\x0d\xb8h6\xf1f\xd3M\xc6\xa9grw\xc1\\ \xd0\xd2\x93\xcd\x1d\x89\xd4\x92\x94g\xdc`\xfe\xf7\xe2Q\xa4D\xf9c\xf6\x80^\xdb\xe3\x0f3\x16\xf9\xf8\xbe\xc8\xf7)}\xf7O\xcf[\xad\x9e\xaf\xb9x\x8eb\x07\xcd\xdel\xa5\x98Lx\xddHe\x80\xa9M\xc3\x94F\xff\x9cKa\xf0\xc1T|=\xcc4{\xff\x1b\x95\x12\xd2?l*\xd9\x03m\x99\xde\x06{\xb6\xc64i^q\x14\xc6C\xfc\xaa\xa5\xf0\xbf+\xb9\xd9p\xb1\xf1\x8fR\xfb_z\xdb\x1a^\xf5O2\xbf\xc3\x1e\x816l\xf8\xbd\xef\xb7\x98}\x13\xa0jUU\xf1u\x8aJI5)\x95\xac\xa1\x15\xdc\x18\xd4\x06\x1c|-\xf3\xbb\xc9\xe4jq\xf3\xe7\xe5\xea\x97l\xf1\x977\xd70\x87x\x02\x00\xa3\xcd\xe9\xe7\xd5\x87\x05\xfdH\xecR P\xfa\xf3\xcd\xcd\xa7\xc5C\x8e\x8d\xe1Rt\xcb\x1d\xa7\xa9\xe15\xca\xd6tso\xa4\x10\x98\x13\x8c\xc33\x9dL\xbe\x83\x9f\x18\xafZ\x85\xa0\x90i)t\x02u\xab\x0d\xac\x11\xb8\x00\xbd\x179\xdcs\xb3u\x87\x94\xae,\xd0d\xb5x}\xbd\xbc\xca>\xad\xde\xff\xe9\xf5\xcd\x02\xe6\x105\x8a\xef\x98\xc1\xc8/\xad\x16\x1f\x97\x7fZ\xbc\xa5%\x85\xb5\xdca\xd1/\xbdY~\xfa\xb2z\xff\xee\xe7\x1bZ\xcce\xb3W|\xb35\xfd\xf2\xbb\xc5\x92\x166(\xfb\xa9\xd7\xef\x16\xd9;G\x88m\xf0r\x13R\xfa\xb8\xf8\xf8\xe3bu\x9d-\xaf>|!\x80\x1a\xeb5*})E\xb5\xef1|Z->\xbe_\xac\x1c\xabXsT\x03\x06\xa7zZ\x13h\xee\xa5\xba\xeb\x97\xde\xbe\xbf\xfe%\xfb\xe9\xf3\x87\x0f\xb4Xp}wY\xb6U\xd5/_\x7f\xb9\xbeY|\xa45\xbd\xd7\x06\xeb~a\xf1\x97\x9b\xd5\xeb77\xcbU\xf6\xe3j\xf9\xcb\xe2\x8a@\xf0\xc1(\x96\x1b\xa9.\xd7J\xde\xa1\xe8\x81?_\xfdr\xb5\xfc\xb3\x85i\xc5\x9d\x90\xf7\"\xa2\x83y\xb3\xc5\xfc\x0e\x0b:\x07\xa9\nT	\x98-B\xc9\x956P3\x93o\xe1\x9e\x0b\xed\x91|z}s\xb3X]\x0dw'>Tw\x02q\xa0\xeed:MFp\xa1\x1e	t\xa4\xc7\xa4\xd7+\xf8\xe7_%\x17`\xb6\\C\xbeeB`\x15\x1dbt\xd7\x83\x90\xedx\x81\x12\xb8\x06\x7fO\x92\xfe\xca\x80];\xb1\xb9;1\xda\xdd\x9fX\x02Q\xc5w\x08\xb8Ca\xe0\x9eW\x15\xacq\xc3\x05-(\xcc\xa5*\xb8\xd8\x10\x19!\x0d\xb0\x1d\xe3\x15[Wx\x84\xfb\xddbIh\xb9\x80\xbdl\x15\xe4\xb2\x15FY\xa1\xac\x81\xda\xc9J\xe6\x8cL\x85f7(A\xa16\x8a\xe7\xe6\x08\x99\xbf\x9c\x841\x97\xa2\xe4\xcaa`\x1b+&]X\xbf\x19\x0b\x9a\xe1\x825\x8d\x92\x8d\xe2$~)\x15hY#\xb4\x1a\x95\x1e\xd0[U\xd2\x18[T\xd2\xcf\x0f\x104\x9c\x8a\xb7L\xc3\x1aQ\x80\xb7\xba\x01\x9eF$$TRlP\x05\xea9\x00a\xb9U\x080\xadeN,\x16\x9d\x0b\xb0g}@\xc5\xa0\xaa\xb9 \x98C,\xfd\x89\xb7\xe2,\xa5\x0e\xe6	\x80\x80\xe4\xd1\xa1\x0e\xf4\x9c\xc6\xbc\xe2\xc6VM\xc7B\xbe\x92\"\x85T\xf0\x87W\xffJG`\xa4\x84\x9a\x89=(\xfc\xadEm\xb4\x9d\xe45\x16 \xdb\xee\x8c\xa7\x93\xc9\xf5\xcd\xdb\xc5j\x05s\xd0{\x9djS\xa0R\x93\xc9\x97\xb7\x1f\xb2\xe5\xa7\x9b\xf7Kkk\xdf,\x17\xd1\xba-KT\x9a\xff\x17F3x\xf9\x03<\x83\x97/^\xfd\xa1c(Rh\x14G\x1d\xcd\xe0\x8fn\xa6TlS\xa30\xd9\xf1\xd2o-G\x13\xcd\xe0F\xb5\xe8\xa6\x84l\x94\xdc(\xd4z<\xbf\x97\xadi\xd7\x98q\x91Wm\x81Y\xc1\xf46\xab\x99\xe0%\xea#\x14Y.+\xa9\xc6\xb39\xab\xaal+kb\xfa'ViO\x90o\x84Th\x15\xa6\x0f\x966(\xb3\xf5\xbea\x87\xbc\xecP\xad\xa5>D\xd4(,QeeY7\xb8\x19o\x10\xb2\xa9\xd8\xbe\xe2\x87\x9c\xde+n0#\xc6\xcc\xb6\xad\xd7\x82\xf1\xea\x80\x14\xab*\xdd\xae\x0d7\x15\x1e\xac\xd8\xbdO\xadqQJ\n\xfb\xe3m\xa5T5#6\xa25jc/\xe5\xf7\xf4\x8b\xb5\x05\x97\xcf\xe9\x97\xbblQ\x8dj\x83\x99lM\xd3\x9al\xd8V\xdf\xed\xa2d\xf28!\xaf}\xf9w\x1d\x93\xc9$\xaf\x98\xd6`#v\xdc\x07\xf9\xe9\xccJ\\`	Y\xc6\x057Y\x16k\xac\xca\x04\x9e1\xb5\xd1\x89\x8b\xe6\xf3+)0\x81g\xcf\xee\xeei\xda\xed\xa2A\xc0i\x07\x04s\x07\x0dR\xc18$\xf5\xe0\xdf\x81\x90\\\xe8\xa6K\x1f\xe0\xd3\xfe\xb5\xda\xb4t\x83?pm\x06\xa4m\x83*\x9e\xa6=K\x8e\x99\x9e\xfed2!\x96\xadH\xbc\xdc\xc7\xf8\x90\xcf\xe0G\xa6q\x10\x0c.\xff\x0d\xb4Q\x1d\xa7Q\x14\xad\xd0\xb4Jh`P\x8e\x12\x15\x90\xa5\x8d\x87\xe8w\x82b\\c\x01\xeb=8\xc3\xb8,\xaa4\x8a\"\x8b\xc9\xa8\xfd \xbc\xf7\xf2\x04\x94\x15UJ)\x9e\xf6\xe9\xd8\xc2\x87i\xab\xf2\x04\xde\xa1\\\xf5\xee\xdb\xceY<\x1d]xos8;=\xe0W\x96g8\x93\x0bL,\\\xceZ\x8d0'<\x1e_F\xd7\x13\xe6\xb0A\xc3\x8cQ\xa4\x9d\x04\"\xbf\x10%@\xa79\xb5\xd0\xbc\x04?\x0fL\x14\xfd\xc3\xed\xcb\xaf\xdeS\x12\xf0\xc0R@\xce\x03N<&\xae\xb9\xd0\x86\x89\x1cc\x0b\x96\xc0\xf2\xdaJ4\xb5\xb8\xed\x1c%\xb0BR.\x12\xdb_\xe9\xe2jy\xfd\xe9M\x02\xee\xe9\xed\x7f~^\xdeL\xcf\xa9\xa0O\xa3\xce\x93<V\xf3Yl\xef\x16\xcb\x8ew\xaa\x0b\xc8;w\xba\x9a\xa6\x95\xbc\xa7\xfbg\xd7(\xacv\xd7:\x81\x86\x19\x83t\x89\xb8\x80\x83ti\xa0\xc1K`b\x1f7\x04d\x11\x13\x06\xfb\xe4\xb7\x07\x0c\x05LuD\x9ePf\x98\xd9\x9f\x95\xc9\x01\xfd\xfe\x91\x9cC\xd0\xa5\xa2\xe7\xf7\x8f\xafuw\xb2\x14P\xdd\xe9>\x90icq\x0e\xfba>;\x99\x1c\x8b\xe0\xd2Xg\xe3\xe4f\xb3\xa2\xad\x9b\xb8`\x86%P\xce\\a\x94\xde\xe0\x83y\xbft\x82\x10Xj\xc1z\xca\x0e>\x01.\n\x14f\xfe*\x01}\xc7\x9b;\xdc\xeb\xb9\xf5\xda\x80B\xb7\n3\xa6s\xce\xe7]t\"W\xc8\xda\xca\xcc+V\xaf\x0b\x06\x0f3k\x00.3\xb0\x7f\xcb\xd4\xc6\x878\xfa\xab\x88\xc8\x13\xfd\xfbPY\xa6\xeeg\xcd\x04\xdb\xa0\xb2NJ\xb7MC1\xd7\xb9\xfb\xd81lS!\xd9\xa0\x88\xa5N\x0b\xdc\x89\xb6\xaa\x12\x88\xee\xa3)0\x0d\xe5\xa0@\x0b\x18\x90PXp\x85\xb9\xc9\xb4)dk\xe2r\x9a\x9c[F\xa5\xe2\xd2\xd1\xf3c\xcf\xb1*\x9cn7h2\xaaXQ\xc5%\xafP\xb0\x1a{\xed.\xad3d\xd5\xad6\xea+\xcc\xad\x16\xacSu%n\xfa\xc1n\xec\x90\xd3\x1c*\x98\xf7\x8b\x1b4\xddz\x1cUr\x13M\x03\xa8T\xa3\xf9\x80;\xacb\x0f\xfcv\xf1\xe3\xe7w\xd3\xfe\xe6\xd3mr\xa0[&\x8a\n\x95\x1eD\xd0F!\xaba\x0e]N\xd5\xcf\xf3\x12z\x11\xfa\xc9\xd1\x06\xabk\x0f\x93@\xc4\"G\x92\x86#\x14Hpm	\xfd\xdc\xcd\xc7\x1d\xd9`CY\x9b\x00\xf8'\x1b\xc2\x0d\xaa8\xba\x88\x99\xce)\x03\x9c\xea\xbf\x9a\x8b\xb8\"II\xb1\xddc\x8dZ\xb3\x0dNu4=\xa4Mz\x19\xf0\x94\xb59	q^s\x81\x86YQx\xc6\x9d`\x8es\xe7+h;*w	r\x85\xcc`\xe6S\xc3l+\xe5\x9d=\x1a\xf4N\x82\xa0*\xb9\xe9V\xc8\xaa\xdc<\x0dJU\xb3B\n\nA\xb4D'\x1fG\x85\xbc\x17\x95d\x05\x16\xd9zoP\x8fbN\xbf\xcdH\xc3\xaa\xd1>;s\xb0\xa5\xdf\xa3\x90Bd\x9f+\xfb\x11\x95\\p\xbd\xc5\"\x9a\x05\x98\xa8\xa7\xd2\xeah\n\xf3y\x00qP[\x10\xdf\x94w\xb5\xe2.Xz\x1cH\xf22\x100\x88\x87\xd6\xeb\x052\x9c\x0c\x95\x03\xcf\xb7\x81B\"2\xa6\x1e\xe9I`\xab\x85\x01\xce>\x9e\x04$\xb6,\\t\x91\xbe*/.\"\xb8\x80x`\x98\x8a\x87\x17\xf0<`4\xb8\xc0\xce\xc2(\x86\xc7Q6\x1c\x7f\x06\x17t\\\xbd?\xd5q\xa7\xf7\xe9\xd1\x15\xb2\xf7\xc1\xe6\xaa\x079\xddg\xa1P\xcbj\x87\xc5\x8a\xd2v\x149\xea\xce\x15n\xd9\xab?\xfe\xa0\xdb:p7\xda\xa8\xc413\xeb\xed\xa9\xf3\x1d\xe3\x1cn\x0bs\xdf\x8cK;D.B\xafa\x0ete\x98Rl\x1f\xbf|\xf5/\xaej\xeaV\xeb\x1d\xcc\xa1\xc6Z\xaa\xfd\x8e\xe3}\xbc\xee\xa6\xfd\xdd{q\xe0\x8c=c\xd4\x08XG	t\x05\x19\x17\x9b\xf9\x8bC\xc7\\J\x05<\x01A\xa1\x1dE[\xa3b\x06cN\xd6\xdb\x05\x8f\x19\xd8\xd4\xb8\xe0\xc2\xc8\xb8\xdeM\x13x1\x0dLg`\xe3\xfb9\x88\xd1\xb4\xf3\x821\x87\x0bx\xf9\xc3\x8b\x83M\x81\xa5w\xc7\xd7\xa9c\x06\x17\xd4\x16\xb0\x92\x0d\xbe\x83\xc66m\x9b\x82\x98\xabw\xb73\xf1u\x1a\x1e\xe46\xdd\xe2C\xc17\xa8M\xec\xf3\xea}Qe\xd2\xfa\x7f\x1d\x9f>\x9a\x04\xf2V\x1bY\x9f\x0e\x17A\xa6NGX\xf0\xdct\x12t\x9b\x08\xb7\x86yw\xc7\xc8M\xe8\xb8[\xa0\xc2!\xfa\xf6\xe8|#\xd3\x1a\x95\x19%B\xc3\xf6\xc4buw\xd2\xe1\xa3\xa6TJ\x7f\xe2\xa0\xb6\x9e\xf6\x10^	\x9dD\xf3\xca	\x12\x14\x15.\x90\x04df\xa7\xed\xe5\x8d\x85\x08\xea\x03p\xea\x9au\xd6\x13`\x18N\"\xe4a\x04\x10\x1e\x07\x01\xb9S@mxM\xae\x99\xec7\xa6\x93\x9euB\x93N\xb90G\xa5\x0d\x951\xf6\xf4\xad\xc5\xfb\xc2FceS2\xba\xaf53:!-\xbf 99)\x17\\\xabp\xa8pF\x96A\x97\x9cb\x1d\x17@\x1ct\xee\xdd5;\xb0p\xb5+\xf9Y\xa9\xe0\x96 \xbe\x0e*\xb3\\\xcci{\xb7\x8dl\x8b\xe6:\xe8\xa3\xe9\xccv\xb3\x1e\x82\xc8\xe8\xcc\x80\xf6\x0cX\x03e\xbd\xe8'{;\"\xd8P\x9f\\\x98\xd8Y\xc4\xfffU\xed\x8e\xeal5M\xb60\xeb_I\xa4W\xacF\xdd\xb0\x1c\x03\xcb\xb6\x95t\xabH\xf5\x04M?\xc7k}\x92\x15\xa4m\x16\xb2\x92\x1bw\x89h\xe4,\xdfbVp\xe5\x11\xd9\x89~\x99\x97\x03\xc4@\x9c\x86\xd4i\xcd\xee(\xbb\xd4q\x0f\x92\x00>pm2ygs\xe6\x80\x8e\xe5\xc9Y^\xe80\xec\xbc7.\xcb\x80\xb3\x8d\xa4#\\p5\xef\xd1\xd3\xb5\xb1\x89\xb7C\\ \x15\xa4\x98\xb7\x06-\"{\xd7\x9d\x8by-\x82\xd2\xdb\x15\xd9\xce\x02\xb3\xa2\x1a\x18\xdb\x17\xa4\xc3a%\xfd\xd2\xfd|\xfb!\xeey\x0e\xe4\x18U\xf44\\\xf5\xbc/\xaa\xd4\xb5\xdam\xa5\x1b\xfb\x03J\xc0G\xf3\xb9c\xddou\xb5|@\xfa\xad\x83\xb4\xc5\x12\xc5\x0f\xeaT\xf4\xf04l\xb7\xc1\xb5e|\x01\xda\xb7]\xc2\x06\xc7t\xda\xf5\x1b\xa8\xd0?\xb4\x10\xe2\xef<\xdaH\xa1n+k\xecX7f\x1f&\xbe\xceL\xbeE\x9c\xf2\xa7\xc1\xc4y\x11M\x13\x88\\'\xf2\xd8\x15M\x1f\xfb\x96\x92\x97\xf1\x1f\xe1\xf2\xff\xbd,<\xc4x\xb3E(H\x03\xc26\xf2\xa1+\xbf\xa4\xdaC\xcd\xf6\xf4\x82Ko\x99\xf2\xcdmi\xb6\xa8\xc0\xf6\xfet\x8f\xc3JG(\x9cYJ\x9d6\xcclS\xb6\xd6\xf4?\xf6\xcf\xf8\xd00QP\xff\xbe3\xecBS\xdau\xca>G\x18\x8fl\xd4m\xe8\xe8R\xf1\xe1\xd5J\xbf\x07\xd1\xec\xb2\x92\x92r\xea\xbf\x95%\x02\xf7\xa9`/\x9akdv\xc2\x99\xba9\x10\x93\xde\xf0\xc4=\xb1\x04\xa2\xd4\xd4M\xf4\x84`\x03\xbe\xf3\xee\xe7;xCN\xc4\x06(gp\x97\xc5\xe01\x07\x0f\xe3D\xb7\x13\xe4n\xbc\xa4\x96+\xc7l\x02\x11\xb90\x0bs\x86\xb1\x1e\xe1S,\x0d\x17\xe8\x7f2\x06\xedZ\xffC\x15\xcfc?\xc5K\xf0\xf7~l\xf1]s\x9cl\xc3\xb5\xb2z\xf3H\x156\x15\xcb1\x8e\xc8Z\xe8\x0d\xc5e\x07\x9c\x0e\xb5\xb9\x1f\x96\xe2m\xd4Hm\x1a%s\xd4Z\xaa\x8c0\xd9:\xe36\xba\xf4\x85\x02\xa1\xa1\xc8=\xfb\xf6\x18\xa5]\x1a\x10\x0f,L\xbf\x1e^\x91\xe3@1\xa2\x1bF\x8d\xd1\xc2(\x82\x8cVdkL\xddT\xf3\xd1\x81\x8e N\\\xd0\x04\xa2\x8b\x98\x17S\xe2\xbf7\x8fAA\x17$\xd6\xc5E4\x85\xef!J/b|0S\x1d\x8d\x90N\x93\xd1c_8Q5\xa4\xe7\xb7'\xcb\xe9@\xba\xe9\xd7\xf1\xfe\xe3\x988^\x7f\xf6\xcc\x1eI?\xf7\x7f\x1e(\x1d0\xb8\xeb\xe0\x9b\xcb\x14\x1e\xc3\xa9\x01-\x01PR\xf0-(\xa4\x0b,{\x04\xa7\xda\x08A,\x1bjx\x8aA\xe3;>D\xac\xd1\xb4\xa5xK\x1boi\xd3\xd7\xaf\xae\xa90\x02r\x91\xcesA{\xbaf\xc6\x13\xa9\x80u\xe9\xf4]\x059\xb2|\x9b\xca\xf5\xaf\x98\x9bx_P\xab.\xc4\x14%#\xf1N\xb0M\xfa\xf2yC|\xebs	W\x8b\xb9\xc6\xfd\xff_\xf2\xa0\xcf'%Og\x0fvi\x0e\xb7\x81\xbd\x93G&\xed\xfa\x9aA\xa7;V\xb5\xa8}\xe7\xd3\x8f\x0e\xef\xd9X\x11\x06\x01B\xd7\x1d\xed\xa0\xaf@\x02\xef\x01\xacc\xd6\xf1\x80\xf8\x80\xe29\xd1\x86\x88\xee\x1a9\xa5lEak9\xef\xda\x02\x0e\x02\xf9i\x90\x13\xd4\x07*\xf0/\x0e\xb4\xcaI\x0b\xf4=QJ\x7f\xfaHo\xc5\x1c\x14\x90@\xf4\xec\x19%\\\n\xf3Vi\xbe\xc3.\xdb>f\xdf\x95D\x1e\x0f\xd7D>\xd6*?\x01K\x83:\xc3\\\xb4\xe3F\x13\x0d\xda\x1e\xc4\xfd!F\x0fY\x85'\xa2\xb0\xa2\xffD%	N-HMN\x05L\xbf\xbb\xe0\x8a\xd2\x8e\x98\x1e\xa6G\xc1\xd3m\xecG\xf7\x9dTJ_\xfat\xf4\xec\xb6\xc9i\xb7>\xeaau\xf7\xc5\x1e\x87\xab\xc2\xbb\xad\xe1\xae\xa37x\xe1(y\xa7\x0fj\x1d\xc6\xa7\xf7\xba\x04\x7fy}>\x9f?iB\xb9l\xab\xc2^UB\x0e\x01\xab\xd1\xa9\xac\xde\x0fj?\x1af\xd2\xeb\xec\xfd\xf5j\xf1..y\xaaMV\xcb\"\xac\x1b\xc3A\xc2\xeb\x945\x0d\x8a\xe28\x14\xfa\xf1\xed\xec\n\x8d\x88D\x8ffG\xa7O\x7f\\\xcc\xb4I`29\xb9\xdd\x8d\x88Zu\xd1,h\xf9\x05\xfb]$\xfc\x1d\x0c\xae\xf6\xe8\x84\xa6\x87\xf3\xf0\x8f\x93\xa3\xa9!T\x1e\\-U\x1b\x8582\xbe\xee;\x85\xac\xfbP\xc1\xd5\xb9nS\xe0\xe6\xbc^\x83Z\xa9\xf3I.\x13\xa2\x8f\x08\xec\xff\xc7\xe0\xbe\xba\xa8\xd3Qs\x8d\x9d\x9aq\xe1\x9d\xa1\xfdD\xd1%\xa9\xf6w\xea\xdf\x88\x7f\xa2'\xff\x16\xd2.\xd9\xc6>%cv=\x8e./\x87\xfc\xed\x0c\x00\x9d\xd4\xd3\x10\x85\xfe\x1d\x002\xde(\x81-V\xcd<\xa2\x07j,\x057X\xdb()[C\xb9#\nM\xdf:=\x890\xcc\xb2\xcf\xd0t\xf9^O\xf6\xb8\xd1\xe6\xbb[>\xa4R\xf8%\xe5S\x8d\xfd\x1f\xd7\xcb\xab\xa7\xf1\xfbz6J\x80\xd9/\x11\xe6\x916t\x05\x8cj\x07a\xe9\x0b\xb5\xbe\xf4\x1dS\xa3\xeb\xf8\x14\x89VU\xbe\xca\xa6\x04\x16\xe6^R\xfb\x8f\xf4\xab\xdd\xc9:\x87N3iO\xcb\xbfT\x1dj.;\xe5\x8b\xc2\xe1\x81\xce\xc3\xdd\xa4@\x9d\xf6\"\xfb\xe3O\xe0\xf2\xd2\xef\xe9N\x13\x98\xfdL\xf3\xb7\x96+,<\x9b\x7fCwi\xd4)\xa1C?\xf7n\xf3\xb0V\xf1r\x0d\x8c\xfa\xe1\x02\xf0\xbc\xef\xa0Y\x89\xa7\xa9Om\xc7v\x88\x95~\n\x87oD\x9c\xc51\xbcK\xee\xe8&\xfe\x83,\xd9\xfaV\xb2\xf3\xf0\xfdw$G>~\xfcR\xdc~Zq\xf8B\x9dF\xad}\x19F\xd9\xd7\x88g\xf7\x91\x8c\xfd\x86\xc2}:39/\xa1\x8bq\xfd\xe7)\xb1\xff\xb6\x93\xbe\x98\x90\xca]B?:\xb2\xd1\xb7\xc7\x19\x04u\x19>\xe4i\x96\xd9|0\xcb\xd2,\xa3K\x90eI\xff\xc1\xc3\x19\xfeF\xdd\xa7\xc9	-~\x8b:\x1efD\x97\xde\x98\xd8\x9d\xd1\xcc\x89\xf8\xd8\xeb\x17\x95\x1ah\x90\xce\xf1\x81\x9b\xf8\xc5\xc3\xe2\x9f\xe9\x95\x03\xa7\x8f\x8f:\x9e\xec\xeb\xba,#\x0f\x99eQ\xa7\x89\x9aq\x11O'\xff=\x00PK\x07\x08\xf5\xb7q\x01h\x0f\x00\x00\xee-\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x00ydl.pyUT\x05\x00\x01\x80Cm8\x94U_o\xeb4\x14\x7f\xcf\xa782/	T\x1e\x17\x89\x97\x8a\n]\xdd\x0d64\xc1\xd4m\x82i\x9a,\xd7>Y\xcc\x1c;\xd8N\xb3\x80\xf8\xee\xc8\xa9\x93\xb6\xb9[\xc5\xf5C\xe4\x9c\xbf\xbf\xf3\xd7\xaan\xac\x0b`}\x96n\xbe\xdd4\xce\n\xf4{J?]\x03\xd6M\xa94f\xd9\xc5\x1fw\xeb\x8f\x9f\xee~[\xdf\xb2\x9b\x07X\x01!$+\x9d\xad\x81\xf6\xb6\x0d\xed\x06!\xa9\xe4\x19\x00\xc0\xc3\x8exu\xb18\xfc\xfdTqcP\xcf\xa8?\xf1\xadm\x9d\n\xe8g\x8cK\xe5\x83u\xfd\x8cz\xad\xb6s\xbb7\x9a\xf7Z\xf9\xf0\x0eynw\x8d\xc2\xd65\x1a\x89r\xc6\xb9E\xeeDu\xce\xc3\xdc\xc3\x8e\xf1&\xf1~}=\xa7W\xb6\x9b\x93\xda\x8d\x17N5AY3\x87s\xe7Z#x@yu\xfe\x1e\xe7s\x1f\xf7\x1e\xddL\xfaw\x1eDu\xcd\xc3\x8eQd\xd9\xcfh\xd0)qu\x01\xab\xd1\xd7\xd5E\x16+\x97e\x12K\xa8\xf9\x0b2\xad\x02\xe6\xc5r0\x84\xaf\xc1q\x11\xaccR9X\x81\xf5\xb4\xe1\xa1\xa2\x7fZer\x92\n\xcd\xa4&\x0b \x93,)\xb2A9*\xec\x9b\x89\xba\xd6\xecZ!\x9e\xc7\xe9\x16\x8f\xef=\xc5W\x14m\xe0\x1b\x8d\x8b#\xde\xb1K\x89\xdb]\xda|t\xb9\xc3\xcb\xff\xee\xd9\xe4\xdc\xd3\xa6'\xc5	\x13\x93d\x0ci\x01\xe4\xb4\xfa\xd3p+\x86\xaf\xa3\xa2B\xf1\xc2\x1c\x86\xd6\x19a%\xe6)\xd2\xaf\xc0Xe|\x83\"\x96\x13n\xfa{\xe3\xd0[\xbdE\xb9\xc6\x12\x1d\x1a\x81~\xb01L\xc8>otr<NK\xe2\xed\xec\xc6I\xf3\xcc\xe1_\xadr(c:1\xe4\xc5\x1b,\xca\xa5\xccO\x86\xc9\x982*06\xc4\x97`\x97\xd6Am%(3T\xa0\xb6\xb2\xd5\xe8\x97S\xeeT	\xc6\x86(B}\xe0.\xf8N\x85*'o\xc1\xa7$u\xccx\x845A\x996\x05\xf2\x7f\x10\x7f=\xf8i\xb4\n9\xa1\xa4(\xe0\x1b \x03\xda=\xd8RE\xac\xd6S/\xb8\x91\xca\x1d\x17\xf3\x00\x81*\xa1TTy\x163\x98\x17\xc0\x8d\x8c\x84\x98\x9e!$ef\xb9\xdd\xab\xc6c=uX\xdb-\xe6I)a\x88\xf1\x83m\xd0\x9c\xce\xf5\xbc\x9b\x80t\xa4\x00\xee\xa1\xdc\xbb)i\x177\\~\xb4F\x93\x9b\xbd\xfb/\xee\xdc\xf7G\xefq6d@z\xa9c~\x17@\x02\xfa@\x9eNu\xf9\xb0\x1e\xa2\xd8\xb8\x19\x8e\xdb\x95I\xbds\xdcK\x0d\xab\xc3\x06Ok\xe6\xfc:\xff\x87\x08.*\x94\xca\x91\xe5\xf4\x8e\xd0g\x0c\xf1\x1e\x8bY\xfc[\x8c6hB\xce\x94)-\xac@\xf3z#9\xf0%\xfcj\x0dNR\xd2vF[.\xf3GR\x85\xd0\xf8\xe5\xd9Y\xd7u\xe3\x03D\x85\xad\xcf\xba\xb8\x03\x7f\xdc\xae\xec\xe5\xf3\xf7\xb7\xbf<\xac/?~\x1b#\xcd2U\x02c\x86\xd7\xc8\x18\xacVq@j\xae\x0cc$\xc5W\x82F\x93\xc7\xa4q\xf7\xbc-\xe0\x07\xf8n_\xbd\xc6)\x13r\x12_\x8d\xd8Z\xca\xc38\x89\xa4\x98\x84\xa2.\xbe\xaa\x90\x7fHU\x11\xf50\xc3\xc9\xe4\xe3\x87\xa7\xd1\xd3\xc0X\x8d\xfbL\x05L \xe29\xd8\xc9\x838\xea\x03\x85X\x91\x03\xd9\xf8\x9b\xf6\x03j\x8f\x9f\xe1m\xcd\x8b\xb1\x9d\x81\x84\xfb\x1d\xac\xff\x0d\x00PK\x07\x08\xd2{C\xc6+\x03\x00\x00\x12\x08\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf5\xb7q\x01h\x0f\x00\x00\xee-\x00\x00\x05\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xfd\x81\x00\x00\x00\x00dl.pyUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xd2{C\xc6+\x03\x00\x00\x12\x08\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xa4\x0f\x00\x00ydl.pyUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x02\x00\x02\x00y\x00\x00\x00\x0c\x13\x00\x00\x00\x00"
 	fs.RegisterWithNamespace("python", data)
 }
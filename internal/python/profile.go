@@ -0,0 +1,106 @@
+package python
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SubtitlesNone disables subtitles in a profile.
+const SubtitlesNone = "none"
+
+// audioFormats select audio streams that do not need re-encoding to the codec.
+var audioFormats = map[string]string{
+	"opus": "bestaudio[acodec=opus]/bestaudio/best",
+	"m4a":  "bestaudio[ext=m4a]/bestaudio/best",
+	"mp3":  "bestaudio/best",
+}
+
+// Profile is a named set of download settings translated to youtube-dl options.
+// Empty fields keep the defaults of dl.py.
+type Profile struct {
+	// Format is a youtube-dl format selector, e.g. `bestvideo+bestaudio/best`.
+	Format string
+	// MaxHeight limits the resolution of the video, e.g. 1080. Ignored if Format is set.
+	MaxHeight int `yaml:"max_height"`
+	// Container is the format the video and audio streams are merged into, e.g. mkv or mp4.
+	Container string
+	// Subtitles are languages of subtitles to download, `none` disables them.
+	// All subtitles are downloaded by default.
+	Subtitles []string
+	// Audio is a codec (opus, m4a or mp3) to extract the audio track with,
+	// the video is not kept. Metadata tags are written into the audio file,
+	// m4a and mp3 files also get the thumbnail embedded.
+	// MaxHeight and Container are ignored for audio profiles.
+	Audio string
+	// Options are raw youtube-dl options applied on top of the settings above.
+	Options map[string]interface{}
+}
+
+// Validate checks that the profile settings are consistent.
+func (p *Profile) Validate() error {
+	if p.MaxHeight < 0 {
+		return errors.New("max_height must not be negative")
+	}
+	for _, lang := range p.Subtitles {
+		if lang == SubtitlesNone && len(p.Subtitles) > 1 {
+			return fmt.Errorf("subtitles: `%s` cannot be combined with languages", SubtitlesNone)
+		}
+	}
+	if _, ok := audioFormats[p.Audio]; p.Audio != "" && !ok {
+		return fmt.Errorf("audio: unknown codec %q, expected opus, m4a or mp3", p.Audio)
+	}
+	return nil
+}
+
+// YDLOptions returns the youtube-dl options of the profile merged over the base options.
+func (p *Profile) YDLOptions(base map[string]interface{}) map[string]interface{} {
+	opts := make(map[string]interface{}, len(base)+len(p.Options)+4)
+	for k, v := range base {
+		opts[k] = v
+	}
+
+	switch {
+	case p.Format != "":
+		opts["format"] = p.Format
+	case p.Audio != "":
+		opts["format"] = audioFormats[p.Audio]
+	case p.MaxHeight > 0:
+		opts["format"] = fmt.Sprintf("bestvideo[height<=%[1]d]+bestaudio/best[height<=%[1]d]", p.MaxHeight)
+	}
+
+	if p.Audio != "" {
+		opts["postprocessors"] = p.audioPostprocessors()
+		opts["writethumbnail"] = true
+	} else if p.Container != "" {
+		opts["merge_output_format"] = p.Container
+	}
+
+	switch {
+	case len(p.Subtitles) == 1 && p.Subtitles[0] == SubtitlesNone:
+		opts["writesubtitles"] = false
+		opts["allsubtitles"] = false
+	case len(p.Subtitles) > 0:
+		opts["writesubtitles"] = true
+		opts["allsubtitles"] = false
+		opts["subtitleslangs"] = p.Subtitles
+	}
+
+	for k, v := range p.Options {
+		opts[k] = v
+	}
+	return opts
+}
+
+// audioPostprocessors extracts the audio track and tags it.
+// youtube-dl can embed thumbnails only into m4a and mp3 files,
+// the thumbnail files are kept in any case.
+func (p *Profile) audioPostprocessors() []map[string]interface{} {
+	pps := []map[string]interface{}{
+		{"key": "FFmpegExtractAudio", "preferredcodec": p.Audio},
+		{"key": "FFmpegMetadata"},
+	}
+	if p.Audio != "opus" {
+		pps = append(pps, map[string]interface{}{"key": "EmbedThumbnail", "already_have_thumbnail": true})
+	}
+	return pps
+}
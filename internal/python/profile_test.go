@@ -0,0 +1,84 @@
+package python_test
+
+import (
+	"reflect"
+	"testing"
+
+	"mkuznets.com/go/ytbackup/internal/python"
+)
+
+func TestProfileYDLOptions(t *testing.T) {
+	base := map[string]interface{}{"format": "best", "ratelimit": 1000}
+
+	p := &python.Profile{
+		MaxHeight: 720,
+		Container: "mp4",
+		Subtitles: []string{"en", "de"},
+		Options:   map[string]interface{}{"ratelimit": 2000},
+	}
+	expected := map[string]interface{}{
+		"format":              "bestvideo[height<=720]+bestaudio/best[height<=720]",
+		"merge_output_format": "mp4",
+		"writesubtitles":      true,
+		"allsubtitles":        false,
+		"subtitleslangs":      []string{"en", "de"},
+		"ratelimit":           2000,
+	}
+	if opts := p.YDLOptions(base); !reflect.DeepEqual(opts, expected) {
+		t.Errorf("unexpected options: %v", opts)
+	}
+	if base["format"] != "best" || base["ratelimit"] != 1000 {
+		t.Errorf("base options modified: %v", base)
+	}
+
+	p = &python.Profile{Format: "worst", MaxHeight: 720, Subtitles: []string{python.SubtitlesNone}}
+	expected = map[string]interface{}{
+		"format":         "worst",
+		"ratelimit":      1000,
+		"writesubtitles": false,
+		"allsubtitles":   false,
+	}
+	if opts := p.YDLOptions(base); !reflect.DeepEqual(opts, expected) {
+		t.Errorf("unexpected options: %v", opts)
+	}
+
+	if opts := (&python.Profile{}).YDLOptions(nil); len(opts) != 0 {
+		t.Errorf("expected no options for an empty profile, got %v", opts)
+	}
+}
+
+func TestProfileYDLOptionsAudio(t *testing.T) {
+	p := &python.Profile{Audio: "m4a", MaxHeight: 720, Container: "mp4"}
+	expected := map[string]interface{}{
+		"format":         "bestaudio[ext=m4a]/bestaudio/best",
+		"writethumbnail": true,
+		"postprocessors": []map[string]interface{}{
+			{"key": "FFmpegExtractAudio", "preferredcodec": "m4a"},
+			{"key": "FFmpegMetadata"},
+			{"key": "EmbedThumbnail", "already_have_thumbnail": true},
+		},
+	}
+	if opts := p.YDLOptions(nil); !reflect.DeepEqual(opts, expected) {
+		t.Errorf("unexpected options: %v", opts)
+	}
+
+	p = &python.Profile{Audio: "opus"}
+	pps := p.YDLOptions(nil)["postprocessors"].([]map[string]interface{})
+	for _, pp := range pps {
+		if pp["key"] == "EmbedThumbnail" {
+			t.Error("thumbnails cannot be embedded into opus files")
+		}
+	}
+}
+
+func TestProfileValidate(t *testing.T) {
+	for _, p := range []*python.Profile{
+		{MaxHeight: -1},
+		{Subtitles: []string{"en", python.SubtitlesNone}},
+		{Audio: "wav"},
+	} {
+		if err := p.Validate(); err == nil {
+			t.Errorf("expected an error for %+v", p)
+		}
+	}
+}
@@ -10,10 +10,9 @@ import (
 )
 
 type Python struct {
-	ydlOpts           map[string]interface{}
 	executable        string
 	root              string
-	runLock           sync.Mutex
+	upgradeLock       sync.RWMutex
 	initialised       bool
 	wg                *sync.WaitGroup
 	cancel            context.CancelFunc
@@ -43,8 +42,8 @@ func (py *Python) Init(ctx context.Context) error {
 	}
 	log.Debug().Msg("Initialising Python environment")
 
-	py.runLock.Lock()
-	defer py.runLock.Unlock()
+	py.upgradeLock.Lock()
+	defer py.upgradeLock.Unlock()
 
 	if err := py.ensureFFMPEG(ctx); err != nil {
 		return err
@@ -74,8 +73,9 @@ func (py *Python) Init(ctx context.Context) error {
 			Msg("Starting youtube-dl updater")
 
 		ticker.New(py.ydlUpdateInterval, ticker.SkipFirst).MustDo(ctx, func() error {
-			py.runLock.Lock()
-			defer py.runLock.Unlock()
+			// Wait for running scripts to finish before replacing youtube-dl.
+			py.upgradeLock.Lock()
+			defer py.upgradeLock.Unlock()
 
 			upgraded, err := py.ensureYDL(ctx)
 			if err != nil {
@@ -0,0 +1,46 @@
+package python
+
+// Reason is a failure class reported by dl.py.
+type Reason string
+
+const (
+	ReasonPrivate     Reason = "private"
+	ReasonRemoved     Reason = "removed"
+	ReasonCopyright   Reason = "copyright"
+	ReasonGeo         Reason = "geo"
+	ReasonAgeGate     Reason = "age-gate"
+	ReasonMembersOnly Reason = "members-only"
+	ReasonPremiere    Reason = "premiere"
+	ReasonNetwork     Reason = "network"
+	ReasonDiskFull    Reason = "disk-full"
+	// ReasonNoStorage is set by the downloader when no storage could ever fit the video.
+	ReasonNoStorage       Reason = "no-storage"
+	ReasonSystem          Reason = "system"
+	ReasonExtractorBroken Reason = "extractor-broken"
+	ReasonUnknown         Reason = "unknown"
+)
+
+// Reasons lists all known failure reasons.
+var Reasons = []Reason{
+	ReasonPrivate,
+	ReasonRemoved,
+	ReasonCopyright,
+	ReasonGeo,
+	ReasonAgeGate,
+	ReasonMembersOnly,
+	ReasonPremiere,
+	ReasonNetwork,
+	ReasonDiskFull,
+	ReasonNoStorage,
+	ReasonSystem,
+	ReasonExtractorBroken,
+	ReasonUnknown,
+}
+
+// ReasonOf returns the failure reason of a script error, or ReasonUnknown for other errors.
+func ReasonOf(err error) Reason {
+	if e, ok := err.(*ScriptError); ok && e.Reason != "" {
+		return e.Reason
+	}
+	return ReasonUnknown
+}
@@ -1,7 +1,6 @@
 package python
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
@@ -22,15 +21,6 @@ func (py *Python) run(ctx context.Context, args ...string) ([]byte, error) {
 	c.SysProcAttr = &syscall.SysProcAttr{Setpgid: true, Pgid: 0}
 	c.Env = os.Environ()
 
-	if py.ydlOpts != nil {
-		envBuf := bytes.NewBuffer(nil)
-		envBuf.WriteString("YDL_OPTS=")
-		if err := json.NewEncoder(envBuf).Encode(py.ydlOpts); err != nil {
-			return nil, fmt.Errorf("could not encode youtube-dl options: %v", err)
-		}
-		c.Env = append(c.Env, envBuf.String())
-	}
-
 	c.Dir = py.root
 	c.Env = append(c.Env, fmt.Sprintf("PYTHONPATH=%s", py.root))
 	return c.CombinedOutput()
@@ -38,7 +28,7 @@ func (py *Python) run(ctx context.Context, args ...string) ([]byte, error) {
 
 type ScriptError struct {
 	ErrorText string `json:"error"`
-	Reason    string `json:"reason"`
+	Reason    Reason `json:"reason"`
 }
 
 func (se *ScriptError) Error() string {
@@ -46,8 +36,9 @@ func (se *ScriptError) Error() string {
 }
 
 func (py *Python) RunScript(ctx context.Context, result interface{}, args ...string) error {
-	py.runLock.Lock()
-	defer py.runLock.Unlock()
+	// Scripts may run concurrently, but not during youtube-dl upgrades.
+	py.upgradeLock.RLock()
+	defer py.upgradeLock.RUnlock()
 
 	if len(args) < 1 {
 		panic("expected at least one argument")
@@ -0,0 +1,164 @@
+// Package sidecar generates metadata files for media servers (Jellyfin, Kodi, Plex)
+// next to the downloaded videos.
+package sidecar
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/storages"
+)
+
+const (
+	posterName = "poster.jpg"
+	showName   = "tvshow.nfo"
+)
+
+type uniqueID struct {
+	Type    string `xml:"type,attr"`
+	Default bool   `xml:"default,attr"`
+	Value   string `xml:",chardata"`
+}
+
+type episode struct {
+	XMLName   xml.Name `xml:"episodedetails"`
+	Title     string   `xml:"title"`
+	ShowTitle string   `xml:"showtitle,omitempty"`
+	Plot      string   `xml:"plot,omitempty"`
+	Studio    string   `xml:"studio,omitempty"`
+	Premiered string   `xml:"premiered,omitempty"`
+	Aired     string   `xml:"aired,omitempty"`
+	Runtime   int      `xml:"runtime,omitempty"`
+	Tags      []string `xml:"tag"`
+	UniqueID  uniqueID `xml:"uniqueid"`
+}
+
+type show struct {
+	XMLName  xml.Name `xml:"tvshow"`
+	Title    string   `xml:"title"`
+	Studio   string   `xml:"studio,omitempty"`
+	UniqueID uniqueID `xml:"uniqueid"`
+}
+
+// PosterPath returns the path of the poster of a video with the given stem.
+// Videos stored in their own directories get `poster.jpg`, otherwise
+// the poster is named after the video files.
+func PosterPath(stem, id string) string {
+	dir := filepath.Dir(stem)
+	if strings.Contains(filepath.Base(dir), id) {
+		return filepath.Join(dir, posterName)
+	}
+	return stem + "-" + posterName
+}
+
+// Write creates an .nfo file and a poster of the video on the storage at root
+// and returns their records. The video must have been downloaded with metadata.
+func Write(root string, video *index.Video) ([]index.File, error) {
+	stem, ok := video.Stem()
+	if !ok {
+		return nil, fmt.Errorf("could not find info.json of %s", video.ID)
+	}
+	meta := video.Meta
+	if meta == nil {
+		return nil, fmt.Errorf("video %s has no metadata", video.ID)
+	}
+
+	ep := &episode{
+		Title:     meta.Title,
+		ShowTitle: meta.ChannelTitle,
+		Plot:      meta.Description,
+		Studio:    meta.ChannelTitle,
+		Tags:      meta.Tags,
+		Runtime:   (meta.Duration + 59) / 60,
+		UniqueID:  uniqueID{Type: "youtube", Default: true, Value: video.ID},
+	}
+	if !meta.PublishedAt.IsZero() {
+		ep.Premiered = meta.PublishedAt.Format("2006-01-02")
+		ep.Aired = ep.Premiered
+	}
+
+	files := make([]index.File, 0, 2)
+
+	nfo, err := writeXML(root, stem+".nfo", ep)
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, *nfo)
+
+	if thumb := thumbnail(video, stem); thumb != nil {
+		path := PosterPath(stem, video.ID)
+		if err := storages.CopyFile(filepath.Join(root, thumb.Path), filepath.Join(root, path), thumb.Hash); err != nil {
+			return nil, fmt.Errorf("could not write poster: %v", err)
+		}
+		files = append(files, index.File{Path: path, Hash: thumb.Hash, Size: thumb.Size})
+	}
+
+	return files, nil
+}
+
+// WriteShow creates a tvshow.nfo file of the channel in the directory,
+// unless it already exists.
+func WriteShow(root, dir string, meta *index.Meta) error {
+	path := filepath.Join(dir, showName)
+	if _, err := os.Stat(filepath.Join(root, path)); err == nil {
+		return nil
+	}
+
+	s := &show{
+		Title:    meta.ChannelTitle,
+		Studio:   meta.ChannelTitle,
+		UniqueID: uniqueID{Type: "youtube", Default: true, Value: meta.ChannelID},
+	}
+	_, err := writeXML(root, path, s)
+	return err
+}
+
+// thumbnail selects the largest JPEG thumbnail downloaded by youtube-dl.
+func thumbnail(video *index.Video, stem string) *index.File {
+	var selected *index.File
+	poster := PosterPath(stem, video.ID)
+
+	for i, f := range video.Files {
+		if f.Path == poster || !strings.HasPrefix(f.Path, stem) || !strings.EqualFold(filepath.Ext(f.Path), ".jpg") {
+			continue
+		}
+		if selected == nil || f.Size > selected.Size {
+			selected = &video.Files[i]
+		}
+	}
+
+	return selected
+}
+
+func writeXML(root, path string, v interface{}) (*index.File, error) {
+	data, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	data = append([]byte(xml.Header), data...)
+
+	fullPath := filepath.Join(root, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		return nil, err
+	}
+
+	tmp := fullPath + ".part"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, fullPath); err != nil {
+		return nil, err
+	}
+
+	hash, err := storages.HashFile(fullPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &index.File{Path: path, Hash: hash, Size: uint64(len(data))}, nil
+}
@@ -0,0 +1,95 @@
+package storages
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+// CopyFile copies a file and verifies the sha256 digest of the copy.
+// The copy is written to a temporary file first, so that dst either
+// does not exist or is complete. An existing dst with the expected digest
+// is kept, so that interrupted copies can be resumed.
+func CopyFile(src, dst, hash string) error {
+	if digest, err := HashFile(dst); err == nil && digest == hash {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	tmp := dst + ".part"
+	out, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+
+	digest, err := HashFile(tmp)
+	if err != nil {
+		return err
+	}
+	if digest != hash {
+		return fmt.Errorf("sha256 mismatch for %s: expected %s, got %s", dst, hash, digest)
+	}
+
+	return os.Rename(tmp, dst)
+}
+
+// RemoveFiles removes the files of a video from the storage at root,
+// along with the directories left empty.
+func RemoveFiles(root string, files []index.File) error {
+	for _, f := range files {
+		path := filepath.Join(root, f.Path)
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		for dir := filepath.Dir(path); dir != root && strings.HasPrefix(dir, root); dir = filepath.Dir(dir) {
+			if os.Remove(dir) != nil {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// HashFile returns the hex-encoded sha256 digest of a file.
+func HashFile(path string) (string, error) {
+	fp, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer fp.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, fp); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
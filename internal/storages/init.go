@@ -13,7 +13,9 @@ const volFileHeader = `
 # Do not delete or edit this file!
 `
 
-func initStorageID(path string) (string, error) {
+// Init returns the ID of the storage at path, a new ID is created
+// if the path has not been initialised.
+func Init(path string) (string, error) {
 	volFile := filepath.Join(path, "storage")
 
 	f, err := os.OpenFile(volFile, os.O_CREATE|os.O_RDWR, 0664)
@@ -55,3 +57,18 @@ func initStorageID(path string) (string, error) {
 
 	return vol.ID, nil
 }
+
+// readStorageID returns the ID of an existing storage without modifying it.
+func readStorageID(path string) (string, error) {
+	f, err := os.Open(filepath.Join(path, "storage"))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	var vol struct{ ID string }
+	if err := yaml.NewDecoder(f).Decode(&vol); err != nil {
+		return "", err
+	}
+	return vol.ID, nil
+}
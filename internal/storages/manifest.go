@@ -0,0 +1,58 @@
+package storages
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+const manifestFile = "manifest.json"
+
+// Manifest lists the videos stored on a volume, so that its contents are
+// known even when the index is not available.
+type Manifest struct {
+	StorageID string          `json:"storage_id"`
+	UpdatedAt time.Time       `json:"updated_at"`
+	Videos    []ManifestVideo `json:"videos"`
+}
+
+type ManifestVideo struct {
+	ID    string       `json:"id"`
+	Files []index.File `json:"files"`
+}
+
+// WriteManifest atomically replaces the manifest of the storage at root.
+func WriteManifest(root string, m *Manifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile(root, ".manifest*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if err := f.Chmod(0644); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), filepath.Join(root, manifestFile))
+}
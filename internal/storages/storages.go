@@ -2,6 +2,7 @@ package storages
 
 import (
 	"fmt"
+	"sync"
 	"syscall"
 
 	"github.com/rs/zerolog/log"
@@ -10,43 +11,232 @@ import (
 
 const freeRequired = 1 << 30 // 1 GiB
 
+// Policy defines how a storage is selected for a new download.
+type Policy string
+
+const (
+	// PolicyFill selects the first storage in the configured order that fits.
+	PolicyFill Policy = "fill"
+	// PolicyMostFree selects the storage with the most available space.
+	PolicyMostFree Policy = "most-free"
+	// PolicyRoundRobin alternates between storages that fit.
+	PolicyRoundRobin Policy = "round-robin"
+	// PolicyPinByChannel keeps videos of a channel on the storages the channel is pinned to.
+	// Videos of other channels are placed on storages without pins in the configured order.
+	PolicyPinByChannel Policy = "pin-by-channel"
+)
+
+var Policies = []Policy{PolicyFill, PolicyMostFree, PolicyRoundRobin, PolicyPinByChannel}
+
 type Ready struct {
-	ID, Path string
-	Free     uint64
+	ID    string `json:"id"`
+	Path  string `json:"path"`
+	Free  uint64 `json:"free"`
+	Total uint64 `json:"total"`
+	// Available is the free space ytbackup is allowed to use.
+	Available uint64 `json:"available"`
+
+	channels map[string]bool
+	// reserved is the space claimed by Select until Release is called.
+	reserved uint64
+}
+
+// State is a configured storage path that may be offline.
+type State struct {
+	Path string
+	// ID is empty if the volume has never been initialised or cannot be read.
+	ID        string
+	Online    bool
+	Err       error
+	Free      uint64
+	Total     uint64
+	Available uint64
+
+	channels map[string]bool
+}
+
+// Fits reports whether the storage can accommodate the given number of bytes.
+func (r *Ready) Fits(size uint64) bool {
+	return r.Available > size+freeRequired
+}
+
+// SelectError is returned by Select if no storage can accommodate a video.
+type SelectError struct {
+	// Size is the space required, including the space kept free.
+	Size uint64
+	// Permanent is set if no storage accepting the video could fit it even if it were empty.
+	Permanent bool
+}
+
+func (e *SelectError) Error() string {
+	if e.Permanent {
+		return fmt.Sprintf("no storage can ever have >= %s available space", utils.IBytes(e.Size))
+	}
+	return fmt.Sprintf("no storage with >= %s available space", utils.IBytes(e.Size))
+}
+
+type storage struct {
+	path     string
+	reserved uint64
+	maxUsage float64
+	channels map[string]bool
+}
+
+// available returns the free space left after the reserved space and the usage limit.
+func (s *storage) available(free, total uint64) uint64 {
+	if free <= s.reserved {
+		return 0
+	}
+	available := free - s.reserved
+
+	if s.maxUsage > 0 {
+		limit := uint64(float64(total) * s.maxUsage / 100)
+		used := total - free
+		if used >= limit {
+			return 0
+		}
+		if limit-used < available {
+			available = limit - used
+		}
+	}
+
+	return available
+}
+
+type Option = func(*storage)
+
+// WithReserved keeps the given number of bytes free.
+func WithReserved(n uint64) Option {
+	return func(s *storage) {
+		s.reserved = n
+	}
+}
+
+// WithMaxUsage limits the usage of the volume to the given percentage.
+func WithMaxUsage(percent float64) Option {
+	return func(s *storage) {
+		s.maxUsage = percent
+	}
+}
+
+// WithChannels pins the channels to the storage.
+func WithChannels(ids ...string) Option {
+	return func(s *storage) {
+		for _, id := range ids {
+			s.channels[id] = true
+		}
+	}
 }
 
 type Storages struct {
-	paths []string
+	storages []*storage
+	policy   Policy
+	mu       sync.Mutex
+	next     int
+	seen     map[string]bool
+	// pending is the space reserved for downloads in progress by storage ID.
+	pending map[string]uint64
+	// selecting serialises Select, so concurrent downloads see each other's reservations.
+	selecting sync.Mutex
 }
 
-func New() *Storages {
-	return &Storages{}
+func New(policy Policy) *Storages {
+	return &Storages{policy: policy, seen: make(map[string]bool), pending: make(map[string]uint64)}
 }
 
-func (st *Storages) Add(path string) {
-	st.paths = append(st.paths, path)
+// Seen marks paths where storage volumes have been found before.
+// A path without a volume is then reported offline instead of being
+// initialised as a new storage, e.g. the empty mount point of an unplugged drive.
+func (st *Storages) Seen(paths ...string) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	for _, path := range paths {
+		st.seen[path] = true
+	}
 }
 
-func (st *Storages) List() []*Ready {
-	rs := make([]*Ready, 0, len(st.paths))
+func (st *Storages) wasSeen(path string) bool {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.seen[path]
+}
+
+func (st *Storages) Add(path string, opts ...Option) {
+	s := &storage{path: path, channels: make(map[string]bool)}
+	for _, opt := range opts {
+		opt(s)
+	}
+	st.storages = append(st.storages, s)
+}
+
+// Probe returns the state of all configured storage paths.
+// Writable paths are initialised as storages unless a volume has been seen there.
+func (st *Storages) Probe() []*State {
+	states := make([]*State, 0, len(st.storages))
+
+	for _, s := range st.storages {
+		state := &State{Path: s.path, channels: s.channels}
+		states = append(states, state)
 
-	for _, path := range st.paths {
-		if err := utils.IsWritableDir(path); err != nil {
+		if err := utils.IsWritableDir(s.path); err != nil {
 			log.Debug().Err(err).Msg("storage path is not writable")
+			state.Err = err
+			state.ID, _ = readStorageID(s.path)
+			continue
+		}
+
+		if id, err := readStorageID(s.path); (err != nil || id == "") && st.wasSeen(s.path) {
+			state.Err = fmt.Errorf("storage volume is missing, run `ytbackup storage init %s` to use the path as a new storage", s.path)
+			log.Debug().Err(state.Err).Msg("storage path is offline")
 			continue
 		}
 
-		id, err := initStorageID(path)
+		id, err := Init(s.path)
 		if err != nil {
 			log.Debug().Err(err).Msg("could not create or read storage id")
+			state.Err = err
 			continue
 		}
+		st.Seen(s.path)
+
+		state.ID = id
+		state.Online = true
+		state.Free, state.Total = diskUsage(s.path)
+		state.Available = s.available(state.Free, state.Total)
+	}
+
+	return states
+}
+
+// List returns the storages that are currently online.
+// The space reserved for downloads in progress is not counted as available.
+func (st *Storages) List() []*Ready {
+	rs := make([]*Ready, 0, len(st.storages))
+
+	for _, state := range st.Probe() {
+		if !state.Online {
+			continue
+		}
+
+		r := &Ready{
+			ID:        state.ID,
+			Path:      state.Path,
+			Free:      state.Free,
+			Total:     state.Total,
+			Available: state.Available,
+			channels:  state.channels,
+		}
+		if pending := st.reservedOn(r.ID); pending < r.Available {
+			r.Available -= pending
+		} else {
+			r.Available = 0
+		}
 
-		r := &Ready{ID: id, Path: path, Free: freeSpace(path)}
 		log.Debug().
 			Str("path", r.Path).
 			Str("id", r.ID).
 			Str("free", utils.IBytes(r.Free)).
+			Str("available", utils.IBytes(r.Available)).
 			Msg("Storage found")
 
 		rs = append(rs, r)
@@ -55,24 +245,126 @@ func (st *Storages) List() []*Ready {
 	return rs
 }
 
-func (st *Storages) Get() (*Ready, error) {
-	for _, r := range st.List() {
-		if r.Free > freeRequired {
-			log.Debug().
-				Str("path", r.Path).
-				Str("id", r.ID).
-				Str("free", utils.IBytes(r.Free)).
-				Msg("Storage selected")
-			return r, nil
+// Select returns a storage for a video of the given (estimated) size
+// according to the placement policy. The size is reserved on the storage
+// until Release is called, once the download has finished.
+func (st *Storages) Select(size uint64, channelID string) (*Ready, error) {
+	st.selecting.Lock()
+	defer st.selecting.Unlock()
+
+	candidates := st.List()
+
+	fit := make([]*Ready, 0, len(candidates))
+	for _, r := range candidates {
+		if r.Fits(size) && st.Accepts(r, channelID) {
+			fit = append(fit, r)
+		}
+	}
+	if len(fit) == 0 {
+		return nil, &SelectError{Size: size + freeRequired, Permanent: !st.mayFit(size, channelID, candidates)}
+	}
+
+	selected := fit[0]
+
+	switch st.policy {
+	case PolicyMostFree:
+		for _, r := range fit {
+			if r.Available > selected.Available {
+				selected = r
+			}
 		}
+	case PolicyRoundRobin:
+		st.mu.Lock()
+		selected = fit[st.next%len(fit)]
+		st.next++
+		st.mu.Unlock()
 	}
-	return nil, fmt.Errorf("no storage with >= %s free space", utils.IBytes(freeRequired))
+
+	st.mu.Lock()
+	st.pending[selected.ID] += size
+	selected.reserved = size
+	st.mu.Unlock()
+
+	log.Debug().
+		Str("path", selected.Path).
+		Str("id", selected.ID).
+		Str("available", utils.IBytes(selected.Available)).
+		Str("policy", string(st.policy)).
+		Msg("Storage selected")
+
+	return selected, nil
 }
 
-func freeSpace(path string) uint64 {
+// mayFit reports whether a storage accepting the channel could ever fit the given size:
+// it is offline, or it would fit the size if it were empty.
+func (st *Storages) mayFit(size uint64, channelID string, online []*Ready) bool {
+	for _, s := range st.storages {
+		if !st.accepts(s.channels, channelID) {
+			continue
+		}
+
+		var r *Ready
+		for _, x := range online {
+			if x.Path == s.path {
+				r = x
+			}
+		}
+		if r == nil || s.available(r.Total, r.Total) > size+freeRequired {
+			return true
+		}
+	}
+	return false
+}
+
+// Release frees the space reserved by Select on the storage.
+func (st *Storages) Release(r *Ready) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if r.reserved >= st.pending[r.ID] {
+		delete(st.pending, r.ID)
+	} else {
+		st.pending[r.ID] -= r.reserved
+	}
+	r.reserved = 0
+}
+
+func (st *Storages) reservedOn(id string) uint64 {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.pending[id]
+}
+
+// Accepts reports whether videos of the channel may be placed on the storage.
+// With the `pin-by-channel` policy a pinned channel is accepted only by its storages,
+// other channels only by storages without pins.
+func (st *Storages) Accepts(r *Ready, channelID string) bool {
+	return st.accepts(r.channels, channelID)
+}
+
+func (st *Storages) accepts(channels map[string]bool, channelID string) bool {
+	if st.policy != PolicyPinByChannel {
+		return true
+	}
+	if st.isPinned(channelID) {
+		return channels[channelID]
+	}
+	return len(channels) == 0
+}
+
+func (st *Storages) isPinned(channelID string) bool {
+	for _, s := range st.storages {
+		if s.channels[channelID] {
+			return true
+		}
+	}
+	return false
+}
+
+func diskUsage(path string) (free, total uint64) {
 	var st syscall.Statfs_t
 	if err := syscall.Statfs(path, &st); err != nil {
-		return 0
+		return 0, 0
 	}
-	return st.Bavail * uint64(st.Bsize)
+	return st.Bavail * uint64(st.Bsize), st.Blocks * uint64(st.Bsize)
 }
@@ -0,0 +1,35 @@
+package utils_test
+
+import (
+	"reflect"
+	"testing"
+
+	"mkuznets.com/go/ytbackup/internal/utils"
+)
+
+func TestDiffLines(t *testing.T) {
+	cases := []struct {
+		a, b     []string
+		expected []string
+	}{
+		{nil, nil, []string{}},
+		{[]string{"a"}, []string{"a"}, []string{"  a"}},
+		{[]string{"a"}, nil, []string{"- a"}},
+		{nil, []string{"a"}, []string{"+ a"}},
+		{
+			[]string{"a", "b", "c"},
+			[]string{"a", "x", "c", "d"},
+			[]string{"  a", "- b", "+ x", "  c", "+ d"},
+		},
+		{
+			[]string{"title", "", "old link"},
+			[]string{"", "title", "", "new link"},
+			[]string{"+ ", "  title", "  ", "- old link", "+ new link"},
+		},
+	}
+	for _, c := range cases {
+		if diff := utils.DiffLines(c.a, c.b); !reflect.DeepEqual(diff, c.expected) {
+			t.Errorf("DiffLines(%q, %q): expected %q, got %q", c.a, c.b, c.expected, diff)
+		}
+	}
+}
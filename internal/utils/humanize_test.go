@@ -0,0 +1,42 @@
+package utils_test
+
+import (
+	"testing"
+
+	"mkuznets.com/go/ytbackup/internal/utils"
+)
+
+func TestParseBytes(t *testing.T) {
+	cases := map[string]uint64{
+		"0":        0,
+		"512":      512,
+		"10 B":     10,
+		"1K":       1 << 10,
+		"1.5 GiB":  3 << 29,
+		"500MB":    500e6,
+		"2g":       2 << 30,
+		" 1 TiB ":  1 << 40,
+		"79 MiB":   82837504,
+		"0.5 kib":  512,
+		"100 kB":   100e3,
+		"3 T":      3 << 40,
+		"1.25 GB":  1.25e9,
+		"1048576b": 1 << 20,
+	}
+	for s, expected := range cases {
+		n, err := utils.ParseBytes(s)
+		if err != nil {
+			t.Errorf("ParseBytes(%q): unexpected error: %v", s, err)
+			continue
+		}
+		if n != expected {
+			t.Errorf("ParseBytes(%q) = %d, expected %d", s, n, expected)
+		}
+	}
+
+	for _, s := range []string{"", "GiB", "1 PB", "1..5 G", "-1 G"} {
+		if _, err := utils.ParseBytes(s); err == nil {
+			t.Errorf("ParseBytes(%q): expected error", s)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+// Package web serves the embedded UI for browsing and playing archived videos.
+package web
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/rakyll/statik/fs"
+	"mkuznets.com/go/ytbackup/internal/api"
+	"mkuznets.com/go/ytbackup/internal/control"
+	"mkuznets.com/go/ytbackup/internal/storages"
+	"mkuznets.com/go/ytbackup/internal/webfs"
+)
+
+// New returns a handler serving the UI and the HTTP API under /api/.
+func New(idx control.Index, sts *storages.Storages) (http.Handler, error) {
+	uiFS, err := fs.NewWithNamespace(webfs.Web)
+	if err != nil {
+		return nil, fmt.Errorf("could not open webfs: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/api/", api.New(idx, sts))
+	mux.Handle("/", http.FileServer(uiFS))
+
+	return mux, nil
+}
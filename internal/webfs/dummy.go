@@ -0,0 +1,4 @@
+package webfs
+
+//go:generate statik -ns web -m -include=*.html,*.css,*.js -f -src ../../web -dest ../ -p webfs
+//go:generate gofmt -w .
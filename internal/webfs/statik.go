@@ -0,0 +1,14 @@
+// Code generated by statik. DO NOT EDIT.
+
+package webfs
+
+import (
+	"github.com/rakyll/statik/fs"
+)
+
+const Web = "web" // static asset namespace
+
+func init() {
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x06\x00	\x00app.jsUT\x05\x00\x01\x80Cm8\xb4Y\xefn\xdc\xc6\x11\xff~O1\xde\x04\x01Y\xd3+)\xc9\x97\x9ep1R\xdbAS(qa\xc7)\nA0\xf6\xc8\xb9\xe3Z\xe4.\xbb\xbb\xbc\xb3j\x1f\xd0\xb7\xe8\x1b\xf4\x15\xfa\xbd}\x93>I1\xfb\x87G\x9eN\xb2\\\xa0\xfar\xe4rv\xfe\xed\xccogF\xac\xb7\x08\xd6\x19Y:v>\x9b\x95ZY\x07\xd6	\x87\xb0\x80\x0f3\x80\x8d\xacP\xdb9\\^\x153\x80\xb2\x16Jac\xe7\xa0p\x0b?\x89.\xcbG\xcbs`\xac\x98\xed\x06>-VR\xbcx\xef,,\xe0\x92\xf1\xf6z\xc3\n`|\x8b\xcb\xd6?\xb4\xdd\xb7\xec\xea<\x12\x8b\xbe\x92zD\xfc\xad\xf04\xba\xebmxX\xaf\xe3\xa6o\xfc\xaf\x10\xa5\xff]5\xa2$.\x91\xcd\x97\xb0\x80LV9,\xbe\x83J\x97}\x8b\xca\xf15\xba\x17\x0d\xd2\xe3\xefn~\xac\xe8\xf3\xf9l\xb6\xeaU\xe9\xa4V\x80M\xe6\xc4\xba\x00\xe1\x9c\xb1\x05p\xce\xcbZ6\x95A\x95{\x17\x04\xc6\xe4\x90\x81aiP8\x8c<iw~>\x03Xi\x03Y\xa0\xbe\xbc.`s\x05z\x05/\x97\xef\xb0t\x1c\x953\x12m\xe6\x85\xc0\xc7\x8f\xf0a\x97\x07\xf6\x00\xc8-\xba\xef\x9d3r\xd9;\xcch\xab\xe7\xb7\x9b\xf2,\x89\xddT5\xda+\xba\x0eU\x95\x95\xc3\x16\x83\xae7\n\xf0|\xb6\x9b\xcd\x84\xbdQ%\x0c\xb6\xae\xd0\x95\xf5\x1f^\xbf\xfc9\xebM36\xcf\xc0\x02\xc4VH\x17H\xfcgb(W\x90=2\\_'\x81\xae6z\xeb\x8f\xff\x851\xda\x10!<\x066\x07\x06\x8f\xc1p\x8a\x9d\xde\x1e\xeab\xf8;\xabU\x96\x1fS\xa9\xd1\xa2\xfa\xd5GY6V(\x04\x1eE\xce\x151k\xd0A\xd9\x1b\xabIQ\xc6\x06w\x9f\x9f'\xc5\xc2\xb6N\x18\xd1\xd26\xd2\xf0\xcd\xab\x8b\xd7(LY\xff\xd1\xaff\x1f\x82vs`\xcf_\xfe\xfc\x82\x15\xd0\xc8V\xba9\xb0\xb3\xd3\xd3SVD\x01;\xaf\xfd\x9e\xe1\x1a\xa7\xbe\xf1\xeec'\xa2\x93'A\xc9\xa7dz\x10\x1c\xb7\x86u\xde\xf5\xb6\xce8\xe7\x9dX#\x0fk\x91\xc0\xbb\xd5/+|\xef\x92	\x00K\x83\xe2:\x90\xd0\xe9\xc3\xde\xe6\x81\xf8\xc0\xb5\x81\xadw\xec\xe0\xd2\x16\x9d\xc86\x81k\"\xe3\xb4\x18\x02oJ\xbc\x92\x0d\xbeyu\x91m\n\xe8\x84\xab'\xbb\xc6V\x9e\x90\x95\xa8J]\xe1\x9bW?>\xd3m\xa7\x15*\x97m8\xa5\xdbc`'\xc4)\x90\x11#n\xbbF\xba\x8c\x9d\xb0\x9c\xb7\xa2\xcbno\xcd\xf9;-\x95\xa7\x98\xaaT\x0b\xfb\xe2\xbd\xcb\x88K\x01\xf8\xde\xd9q`t\xb0\x08\xfc\x9d\xbe\xd0[4\xcf\x84E\x8a\xac\xc1#\xb4\x81[\xddb\x96y\xdf.\xbe\x83\x8e\xa3\xaa\xec\x9f\xa4\xab\xfd\xd2\x818\x83\xaaB\xf3,b\xdb$\n\x95\xd8\xc0\x02\xbe\xccXB>R\x15h\x99K\xa5\xd0\xfc\xfe\x97\x9f.b@\x0eH!\x9a\x06\x16\x84*\x8c \xecCmp5\x07\xf6\xc5	\xdb\x15\xc0\xbeo\x9a\x01EY\xe1\xa9l'\x14\x11\xee\nx\xed\x8cT\xeb\x8c\xc24\x05\x0coP\xad]\x9d\xe7\xfbt\x0c\x9f#\x97\x14;\xa2ix\xd9\x08k/\xa4u\\TU\xc6D\xe9\xe4\x06\xd9\x90\x8c\xa4uD\x0b\xd14\xf9H\xe5\xa4\x11\xa5\x1b\xe7|\"\xc0\xf2\x8dhz\xb4Y~\xc5\xad6.\xcbD\x01K\xefV\xc1\x9dt\x0d\xf2F\x97\xa2A:Wa0[\x86\xd5\xfc\x10\x13\xcb:\x00X0>\xe9\x1d\xd1\xff\xa8\xc7\xa2ZwE^YS\xe8\xed\n(\xeb \x92\xc2\xdb/\xde\xe5\xd8\xb2\xe6\xa5\xee\x95\x8b\xde\x0c\xfe\x9cX\x0b\x8b\xc5\"\xf0H\n\x02\x88\xfb\x1c\x9b\x12u\xec\xdc\xe8\xf1qH\x87\x18#\x16\x93\xf8\xfaK\x8f\xe6&D\x98\xf5H\xc5\xf2\xe0n\xee\x8cl\xb3\xfcv\x90\x07\x7f\xdd\xa0 P\xf82c\xf4\x946\xed\xbf\xd3AE\xb6\xda\xb8\xfd\xf7\x88\xa4\x03\xb8N\"m%\x1b\x87&#\xe4X|\x17\xad\x0f\xe2ZX$P\xb9\xcbo_}\x05mr\xe2[Y\xc1\xa3E\xe2\x1e\x17\xf7\xfe\x8cy\xba\x12\x8d\xc51\xd6\x11S2\x87x=\xcaZ\xde\xf5\xcbF\xda\x1a\xab\xb7\xc2\xd1\xd12\x96Sh\x1a\x172\x99H\xf3Os\x8d\xd2\x1e\x05W\x7f\xfc\x08Y\xbb\x8f\x16\xc6\x0e\\\xcc\xa5*\x9b\xbeB\x9byzo.]\x08C)t\x98\x03\x137\x89\xe4'\x91\x17\xd0.\xd3\xdb2z\xcdn\xa5+k\xc8\x88\xc5^\xf1RX\x04V	\x87O\x84-\xd9<.\x0f\x9ag\xad8\xea\x8ai\xd6\xb5\xcbcD\xe7\x91Y\x90\xe1\xcd>.`\xe2\x91[\x9c\xc7_\x13\xcb\nW\xa2o\xdc\x11nG59dz\xd4\xa6}4\xec&\xf0\xa4\x95C\x15\x03:\xbe\x04\xdb\xe2\xcb10\xa6h\x9a@\xa8O\xed\xd3\xe4\xf7\xb43\x02\"\x81OG(\xecS}\x0e\x8cb\xb7\xb7\x1e\xb3\x7f\xd6\xf1\xecY\x82\x8d\x10S\xa4\xedn\xaf\xa5[\xea\xea&\xe2\x98\x7ff\x87\x08\xb8!\x00\x8cu\xc0^\x0d\n\x9c\x14)\x9b(\xc0\xef\x1f\xeb\xe6\x0c)\xb7+\xa2\xb7\xbd\x8cj\xac/\xc5\x0fi{W\xde4\xb2\xc4\xec\xb4\x80\xb3\xd3<\xbf\xcdeW|>\xfcN\xd2=\x9c\xdf\xae\x18\x81\xc08h\x1e*\xd2;\xe7.\x81\x9b\x08\xf7\xa3\xfc\xf5K\x91y\x1ea\xf7\xe8\xd9:\xb1l0Z\xea\x9d\x9b\x1f-F\x03L\xffJZP\x9b0B\xea\xcf\x8f\xc1\xcf\x8a\xb1\x0b-*\xa9\xd6\xff\xf9\xdb?|\x90%\x9c&.\xce\xdc\xc4`\xd9|\xa2\x10\xbd\xcbq\xb2\x8a\xbe\x81Rx\x00\xc2\xc3,8\xcc\x1f\x80\xcf\xd2\xde;\x0c\x94v\xb0\xd2\xbd\xaa\xee\xcf\x93\x83`\x0f\x8b\xbev\x84\x05l\xe8\x16\xf2G\x1b\xea\xfe\xbb\x14\x1c\xce\xc57\x99\xb0\x08\x0c\x86+l\xe5\xeb\x93XG\xae8\xd5\x8b\xc5\xbe\x1f\xcd\xf3C\x14_r+\xff\x8a\xf0\x04\x84\x7f\xc8/O\x93\xf4\xd4\x9a>L\xc4\xd0\xc5>X\x04\xc1\xd4#\xaf\x19]{~\xff\x14\x1b:m\x1d\x9a\x87\x89\xbfd\xfc]\xb7fW\x0f\x96\x1e\xe4\x07\x11I\xec\xd1\xb8\x95\xedz\x8c6\xa5\xde !\x925\xe5|\xd2AxN\xde\xdfy\x01\xa2q4\x13\xd8\xe5\xf9\xa4\xa5\x89G:B7o\xb6\xe7\xaf\x953\x9a\x06\x0c\x8c\x15\xd0\x19\xa4\xeep\x0e\x8c\xc0\xb1\x12N\x1c\x11\xe9\xf7\x06\x89Q\xd0.\xba\xd5{u\xeaL\x8f/\x11\xa3\xfd\xf3\xff(\xd4\xb3NBI\xe6\xa4S_\x11\xce?  /\x19\xdf8G\xc75\xf1\xbdu\xd0\x08\xb5\xa6#\xe7\xe3N\x8a\x0f8\xfe\xe4\xeb\x02\x9e\x9c\xd1!R\xa6\xa4\x84\x8d%\xca\xf4\xda\x10\xe55\xd9x-\x15\x99d\xfb\xa5\x87e{\xc4\x91\xabtl\xd6\x94$\x7f\xee\xb5(\xa0\x11K\x9a\xed\xd0\xcb\xfd'\xe9\x1d\x1aO\xe08\xfa\xd5_G\x08\xbe\x05\xe1\xe7\xb3\xa3\x811\xb9\x92)\x08X\xbc\x02\xff\xaf\xb7U\x10\xc1\xe0_\xff\xf4S\x8d\x07\\\xa9\xd4\xffz\xf2\xe3\xda\xd5\xceuv~r\xb2\xddn\xf9\x8d\xee]\xbfD^\xea\xf6dKp\xfct\xb3\xb8Cg\x7f\xbb\x15\xe0\x84Y#e\xd2\xdbe#\x14\x1d\xa7\xa1\x03aJ\xeb\x0e\x15\x1a\xba\xf6\xd9\x9fu\xffK\xbf\xc4\xa0|\xbcB|\x12p'\xd6\x96\xb0%<\xa5~2\x06\xdc\xa7|N\x9b\x89?\xe7<\xee\xa7^>s>\x9c\x0f\x9a,j\xab\xa6	\xc8+\xb4\xa5\x91\x1du\xf5\xf7H\xac\xe4f,s\xb4\x89DO\xb9L\xe2\xcb:\x90j5\xc6fU\xa5\\\x8b\xb934\xfe\x8c\x13\xa9\x1fD\xc5\xfb\x89T\xa4\xb5\xa4\xd9\xfe\xa2M\xcc	r\x8e\xdc\xb9\xa3\xa4\xa1\xfd^P\xca\x8c\xb4\xd5\xe8-\xddg\x97\x91\x1f\xc0%{\xde\x1bA6\xb0\xc23\xe6U|\x87\xa7~Z\xf5\\8\xcc\xa6\x1f~\x034\x99\xa2.\xf0\xc7\xd7/c\x0f\x9b0\xe0\xec\xac\x80\xb3\xdf\xe6@\xa8u\x95\xca+\x12\xf3\xab\xc4\xadM26\x12\xb7o}\xcf;\xa1\xb9\x90\xd78\xd04\xf2\x1a\x8f\xd0\xbc\xe9\x08\x07=\xcc{V}|\x9f0z\x85V7\xfd\xd8\xaa\xad\xac\\M\xf1\xe67\xd5(\xd7\xb5\x83\xa7\xe1-|{\x0c\xec\xdf\x7f\xa7\x90\x1fS\xdc2\xe3\x07mZ\xe1\x92\xf0\x95\x7f\x1bD_\x0d\xc0j\xfca\x9b\xcb\xb3+\xdfr\xf6\xaa\xc2\x95TX\x91\x06\xc3\xaa\xea\x9bf\xb20n\x8dng@\xfdM\x8ch\xf6\x1c\x9d\x90\xcd\xbe\xf0\xffdmI)\xe1\xeb\xcb\xf8\xce9\xa7H\x08Yc\x86\xacI\xe5|\xa0\xaf\xe3\x8b\xb9<\xbd\xca\x8bi\x8d\x1cO\x9dL\xa1\xf4\x1a&\x17\xb7k9\xaf\x9b\xd5\x0d\xf2\xad0*\xc3Dx\x1f\x1e\x0f\x86\xfe@\xb7U4\xf3\x08a\xdfDB\xce\xc9\xf3\x18\x0dZ\x0d\x0652\x12\x1c\x00\xf3\xed\xfbe\x97n\x9a\xf1\x80\xb0\xd3]\x96\xac\x9b\x8cM4M\xc4\xc7ux-lM\xa3x\xbc\x85\xf0\xd4`R r\"II\xb2\xcf\xf4\xb0\xba\x1f\x1f\xb0\xd4l\x0c\x97\xef\xb8\xf8\x1f\xf1\x18\x08\x87Q\xdc\xed&\x10\xa6\xb3\x0eX\xc0mq\xb1[:a9<\x8d\x9f#\xff\xe1\xcb\x00\xce\xf3x\xa9\x1f\x8e&\xf7ka\x94t\xac\x87i\x85T\xd1e{D\x1b\x0fz\x06D\x1b\x8f\xde\x8f\xf7\x07\xe3^\xe7h\x930!x@/\xfdL\xf7M\xe5;\x05\x12\x1e\x9b\xe1\xf0\xcf\x03\xe4-Z+\xd6qr\x98\x06\n\x07xOS\x9f4\xdf\x7f\x8d\xde\x05\x93\xda\xcb\xf7\xd8ck\xf3\xfb'Yi\x00\n\x07\xe3*K\xff5\x9a\xf4\xb89]\xfc\x1fd5\x9f\x0c\xba\n\xf0\x95\xc3x\xd1/\x14\xe0\x11u\x0e\xa7\xbb\xd8N\xc5\xd9\xe3\xe3\xc7q\x184\x0e\x18\xcb\xed\x814\x9ajF\x15\xe5\xea\xb0\x04I6Ap\x87\x1f\xf7N)bl\x9d\x16\xf0mr\xe7.\xde\xcc#g\xdd\x90\xb3h\xe2\xeb\xd9\xc4\xf9n\xce\x0dn\xd0\xd0T=\xc9\xd9\xcf\x19GG\xac\xbb\x08\xfb\x1f\xfcpq\x0e7\xbb\x02nF\x17\xf4H\x90\xac\xbc\xa44\xe4, \xf0+\x80Q\x9f\xc2\xae\xf6\x82d\x95\x939/6\xa8\x1c\xc58\x158\x19\x93\xaa\xeb\xe9.\xc8F3\xb7P\xe4\x1fd\xfd(\xbf\x87i\xc2`\x06\xfdM\xe8\x0f\x0f\x1d\x9e>\xa0\x92\x9c\x1c\x9c\xbf\x7f\xbf8\x19*\xf0\x1d`cq$o<\xf8\x1d\x88\xfc\xefn\xef\xa9\xadT\x95\xde\x1e\xb1\x9b\x94$Ik\x9a]x0\xf4\x9b\",\xfa\xeco\x85TY~>\xfb\xef\x00PK\x07\x08\x166\xf9\x1a\xef	\x00\x00\xcb\x1d\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\n\x00	\x00index.htmlUT\x05\x00\x01\x80Cm8\x8cR\xbd\x8e\x141\x0c\xee\xef)\x8ci\xd9\x8b\xe8(\x92\x91\x10\xd0!\x0e\x89k(}\x89w'\\6\x13\xc5\xdeYM\xc7\xd3\xf0`<	J\xb2\xab;\xc1\x15TQl\x7f\x7fN\xec\xab\x8fw\x1f\xee\xbf\x7f\xfd\x04\xb3\x1e\xd3tc\xdb\x01\x89\xf2\xc1!gl\x05\xa60\xdd\x00\xd8#+\x81\x9f\xa9\n\xab\xc3\x93\xeew\xef\xf0\xa9\x91\xe9\xc8\x0e\xd7\xc8\xe7\xb2TE\xf0KV\xce\xea\xf0\x1c\x83\xce.\xf0\x1a=\xef\xfa\xe5\x0d\xc4\x1c5R\xda\x89\xa7\xc4\xee\xed\xa0\xd1\xa8\x89\xa7M\x1f\xc8?\x9e\x8a5\xe3\xde\x04R\xcc\x8fP99\x14\xdd\x12\xcb\xcc\xac\x08s\xe5\xbdC\xd3K\xb7^\xa4\x995\xc3\xad}X\xc2v\xf1\xce\xb5s\x10\xf8D\"\x0e\xd3rX\xae\xe0\xd7\x06\x9f	R\x1f\x8c\xb9\x9c\x14bp(L\xd5\xcf\x08\xba\x15~\xba\x95D\x9e\xe7%\x05\xae\x0e\xbf\xf5\"t\xab\xcd\x00\x80\x15N\xec\x07\xc1\xc6T{\x11\xc0.E\xe3\x92a\xa5tb\x878\xbdO	Z_\xac\x19\xad6g\xcd@\xffM$m\xa5/\x12\x05R\xde\x05\x16\x8f\xd3\x17>\xb3(\xecc\x15}N\xfa\x8fx\xc7P\x83\xdc\xa5\xf0\x7f\x90\x1e\x10\xa7\xfbv\xbclx\xac\xbe-\xdb\x1e)\x8e4\x99\xd6\xbe\x07?S\xce\x9c\x04'k2\xad\x97t\xbe\xe7\xe8\xfd\xf1W\xae	\xcb\xf5\xa9DIO\x82\xd3\xe7\x85B\xcc\x87\xdf?\x7fYS\xae\xb2\x1d\xde\x9e|\xc8Y\xf15\x16\x05\xa9\xde\xa1\xa1Rn\x7ft\xbdQns\x97?af=\xa6\xe9\xe6\xcf\x00PK\x07\x08q\xf9\xb9J\x83\x01\x00\x00\xf9\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00	\x00	\x00style.cssUT\x05\x00\x01\x80Cm8\x94U\xcf\x8e\xf26\x10\xbf\xe7)F\xdf\xaaR[a\x14(K#\xef\xad\x87J=\xf4\xd2O}\x00'\x9e$.\x8em\xd9\x03\x84]\xf1\xee\x95\x8d\xc3\x92%=T\x08\x89$\x93\x99\xdf\xbf1?\xc3G\x01P\xdb\x91\x05\xf5\xaeL\xc7\xa1\xb6^\xa2g\xb5\x1d\xdf\x8akQ\xd4V^R\xcd |\xa7\x0c\x87\xf2\xad\x00h\xad!\xd6\x8aA\xe9\x0b\x07&\x9c\xd3\xc8\xc2%\x10\x0e+\xf8M+s\xf8S4\xdf\xd3\xf5\xef\xd6\xd0\n\xbe}\xc7\xce\"\xfc\xfd\xc7\xb7\x15\xfcekKv\x05A\x98\xc0\x02z\xd5\xde;\x06\xf5\x8e\x1c6;7\xc6[\x8d\xd5\xd6sx\xd9n\xb7\xf1\xb2\x16\xcd\xa1\xf3\xf6h$\x87\x97V\xc4OB(\xe0\xe3\xa1\xb8\xdc\xbf\xb6r\x17\xeb	Gb\x12\x1b\xeb\x05)k8\x18k\xf0\xf6\x06\xef\xed	=|,U\x1d\x8dD\xafU.\xedQ\xc8\\)UpZ\\8\xb4\x1a\x13\xbeN8\x0e\xd5\x0d\xab\xd0\xaa3L\x11\x0e\x81C\x83\x86\xd0\xc7\x12'\xa4L\xaaVn\x84\xcd\xde\x8d\xcfD\xdaD\xff\xae:\x91\x1d8l\xdc\x08\xc1j%\xe1EJ\x19\x0b\x9c\x0d\xeaF#\x90j\x0e\x97x\x8f\xacK~|\xe2\\k\xdb\xd9\xc4+9tF\xd5\xf5\x14=\xd5\xf2\xab\xc8\x19\xf8\xcdV\xe6o\x85\x9b\xfd\\\xfa\xa6\x9c\xb5W\xc6\x1d\xe9\xd6^\xe3\xc8as\xeb0\xb2\xb3\x92\xd4s\xd8U\xa5\x1bg\xbcwn\x8c\xdcS\x93A(\xb3\xa4\xe4\xb5(\x8c8\xa5'\xb9\xcfv\x9f\xfb\xc41,\xf4^\x99CN^\x9f)5B7?n\xca\xf2\xd4\x03\x83\xdd\xab\x1b\x7f\x8aO\xa3\xad\xad\xb6gv\xe1 \x8ed\x1f\xa4\x9d\x18>);\xcfU\xdb\xde\x01\x89%\xb0\x00\xff\x1c\x03\xa9\xf6\xc2\x1ak\x08\x0dq\x08N4\xc8j\xa43\xa2\x99\xb9\x1e\xd9o\xb6\x0bi\x9e&\xacEC\xea\x84\xf0\xf1\x15\x07\"\xbe-\xbbx\x07\x17\x9c0\xb3\xe8WU\x95\xc4|\xc9\xc8\xbe\x1au\x0f\xe3\xe4\xf2\xff\xd5\xf2Z\x14$j\x8d\x8fVm\xca\xf2\x87\x07\x95\x1b\xab\xb5p\x019L\xbf\x12$\x92+\xa0\xfes\xe1\xd2\xbap\xd0\xd8\xd2\x93`9\x98\xff\xbd\x11Y\x9b\x13zR\x8d\xd0S3\xb2.\xcfZKA\x19d\xaf\x08Y2(\xee\xff\xd9\x0b\xf7\xe8\xc5~\xbfO\xaf\x9c\x94D\xbb\xc4j\x10#\x9bT\xfa\xb5<\xf5O\xe7P\x99\x17D\x1c\xa5Z\xe8p-\x8aus?k\xeeQ\xaa\xb5m\x0e_vgi\xe4.\x8f\xccK:iQ\xae_qH\xc0\xd7\x03\xd2\xfc\xfc\x9b(\xad%\x86\xc6+\x17\xcf\x8cg)\x9cG6\x89\xf1\x9c\xffI\xfc%\xd5?C\xb4\xcdk\xbd&\xd1\x85\xcf4\xdeI*\x13OQ\xf6\xc05\xff\x81$\x97\xe3\xb7\x9cy\xbfu#\xe4`\xce e\xbbs\x1e\xbc\x90\xea\x188\xfc2M\x0f$\xe8\x18f\x1aTU\xf5V\\\x8b\x7f\x07\x00PK\x07\x08\xaf\xb9/d\xca\x02\x00\x00\xdc\x06\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x166\xf9\x1a\xef	\x00\x00\xcb\x1d\x00\x00\x06\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00app.jsUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(q\xf9\xb9J\x83\x01\x00\x00\xf9\x02\x00\x00\n\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81,\n\x00\x00index.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xaf\xb9/d\xca\x02\x00\x00\xdc\x06\x00\x00	\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf0\x0b\x00\x00style.cssUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x03\x00\x03\x00\xbe\x00\x00\x00\xfa\x0e\x00\x00\x00\x00"
+	fs.RegisterWithNamespace("web", data)
+}
@@ -0,0 +1,139 @@
+package youtube
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/api/youtube/v3"
+)
+
+// CostList is the quota cost of a list request regardless of its parts.
+const CostList = 1
+
+// Priority determines whether an API call may use the reserved part of the daily budget.
+type Priority int
+
+const (
+	// PriorityHigh calls can spend the whole budget (e.g. metadata of videos to download).
+	PriorityHigh Priority = iota
+	// PriorityLow calls are postponed until the next reset when
+	// the unreserved part of the budget is spent (e.g. playlist re-scans).
+	PriorityLow
+)
+
+var pacific = loadPacific()
+
+// QuotaStore persists the daily quota spend.
+type QuotaStore interface {
+	AddQuota(day string, units int) (int, error)
+	SpendQuota(day string, units, limit int) (int, bool, error)
+}
+
+// QuotaError is returned when a call is not made because of the quota budget.
+type QuotaError struct {
+	Deferred bool
+	ResetAt  time.Time
+}
+
+func (e *QuotaError) Error() string {
+	if e.Deferred {
+		return fmt.Sprintf("low-priority calls are postponed until quota reset at %s", e.ResetAt.Local().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("daily quota is exhausted until reset at %s", e.ResetAt.Local().Format(time.RFC3339))
+}
+
+// Client wraps the Youtube service and accounts the quota spent by every call.
+type Client struct {
+	*youtube.Service
+	store   QuotaStore
+	budget  int
+	reserve int
+
+	mu       sync.Mutex
+	reported int
+}
+
+func NewClient(service *youtube.Service, store QuotaStore, budget, reserve int) *Client {
+	return &Client{
+		Service: service,
+		store:   store,
+		budget:  budget,
+		reserve: reserve,
+	}
+}
+
+// Do runs the API call if the remaining budget allows it for the given priority.
+func (c *Client) Do(priority Priority, cost int, call func() error) error {
+	now := time.Now()
+	day := QuotaDay(now)
+
+	limit := c.budget
+	if priority == PriorityLow {
+		limit -= c.reserve
+	}
+
+	// Failed calls are charged as well
+	spent, ok, err := c.store.SpendQuota(day, cost, limit)
+	if err != nil {
+		return fmt.Errorf("could not update quota: %v", err)
+	}
+	if !ok {
+		return &QuotaError{Deferred: spent+cost <= c.budget, ResetAt: NextReset(now)}
+	}
+	c.report(spent)
+
+	if err := call(); err != nil {
+		if IsQuotaError(err) && spent < c.budget {
+			// The actual quota is lower than expected, consider it spent until reset.
+			if _, e := c.store.AddQuota(day, c.budget-spent); e != nil {
+				log.Err(e).Msg("Could not update quota")
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// report logs the quota usage every 10% of the budget.
+func (c *Client) report(spent int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	step := c.budget / 10
+	if step > 0 && spent/step > c.reported/step {
+		log.Info().
+			Int("spent", spent).
+			Int("budget", c.budget).
+			Msg("Youtube API quota")
+	}
+	c.reported = spent
+}
+
+// QuotaDay returns the quota day of the given time. Quota is reset at midnight Pacific Time.
+func QuotaDay(t time.Time) string {
+	return t.In(pacific).Format("2006-01-02")
+}
+
+// NextReset returns the time of the next quota reset after t.
+func NextReset(t time.Time) time.Time {
+	p := t.In(pacific)
+	return time.Date(p.Year(), p.Month(), p.Day()+1, 0, 0, 0, 0, pacific)
+}
+
+func loadPacific() *time.Location {
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		// No tzdata available, ignore daylight saving time
+		return time.FixedZone("PST", -8*60*60)
+	}
+	return loc
+}
+
+func isQuotaError(err error) bool {
+	var qErr *QuotaError
+	return errors.As(err, &qErr)
+}
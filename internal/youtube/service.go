@@ -2,6 +2,7 @@ package youtube
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"golang.org/x/oauth2"
@@ -25,8 +26,13 @@ func NewService(ctx context.Context, token *oauth2.Token) (*youtube.Service, err
 	return service, nil
 }
 
+// IsQuotaError reports whether the call failed or was not made because of the API quota.
 func IsQuotaError(err error) bool {
-	if gErr, ok := err.(*googleapi.Error); ok {
+	if isQuotaError(err) {
+		return true
+	}
+	var gErr *googleapi.Error
+	if errors.As(err, &gErr) {
 		for _, e := range gErr.Errors {
 			if e.Reason == "quotaExceeded" {
 				return true
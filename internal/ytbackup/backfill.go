@@ -0,0 +1,88 @@
+package ytbackup
+
+import (
+	"path/filepath"
+
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+type BackfillCommand struct {
+	Command
+}
+
+func (cmd *BackfillCommand) Execute([]string) error {
+	idx, err := cmd.LocalIndex()
+	if err != nil {
+		return err
+	}
+
+	roots := make(map[string]string)
+	for _, r := range cmd.Storages.List() {
+		roots[r.ID] = r.Path
+	}
+
+	videos := make([]*index.Video, 0)
+	err = idx.Iter(index.StatusDone, func(video *index.Video) error {
+		videos = append(videos, video)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	updated, skipped := 0, 0
+
+	for _, video := range videos {
+		if cmd.Ctx.Err() != nil {
+			break
+		}
+
+		info := readInfo(roots, video)
+		if info == nil {
+			skipped++
+			continue
+		}
+
+		if video.Meta == nil {
+			video.Meta = &index.Meta{}
+		}
+		if !video.Meta.Backfill(info) {
+			continue
+		}
+
+		if err := idx.Put(video); err != nil {
+			return err
+		}
+		updated++
+	}
+
+	log.Info().Int("updated", updated).Int("skipped", skipped).Msg("Metadata backfill done")
+
+	return nil
+}
+
+// readInfo reads the info.json file of the video from any online storage.
+func readInfo(roots map[string]string, video *index.Video) *index.Info {
+	stem, ok := video.Stem()
+	if !ok {
+		log.Warn().Str("id", video.ID).Msg("Could not find info.json, video skipped")
+		return nil
+	}
+
+	for _, st := range video.Storages {
+		root, ok := roots[st.ID]
+		if !ok {
+			continue
+		}
+		info, err := index.ReadInfo(filepath.Join(root, stem+".info.json"))
+		if err != nil {
+			log.Err(err).Str("id", video.ID).Str("storage", st.ID).Msg("Could not read info.json")
+			continue
+		}
+		return info
+	}
+
+	log.Warn().Str("id", video.ID).Msg("No online copy of info.json, video skipped")
+	return nil
+}
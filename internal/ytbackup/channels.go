@@ -0,0 +1,72 @@
+package ytbackup
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"mkuznets.com/go/tabwriter"
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+type ChannelsCommand struct {
+	Command
+}
+
+func (cmd *ChannelsCommand) Execute([]string) error {
+	channels, err := cmd.Index.Channels()
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]*index.Channel)
+	for _, ch := range channels {
+		byID[ch.ID] = ch
+	}
+
+	counts := make(map[string]int)
+	err = cmd.Index.Iter(index.StatusDone, func(video *index.Video) error {
+		if video.Meta == nil || video.Meta.ChannelID == "" {
+			return nil
+		}
+		id := video.Meta.ChannelID
+		counts[id]++
+		if _, ok := byID[id]; !ok {
+			ch := &index.Channel{ID: id, Title: video.Meta.ChannelTitle}
+			byID[id] = ch
+			channels = append(channels, ch)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(channels, func(i, j int) bool {
+		return strings.ToLower(channels[i].Title) < strings.ToLower(channels[j].Title)
+	})
+
+	tw := tabwriter.NewWriter(os.Stdout, 10, 1, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tTITLE\tVIDEOS\tSTORAGE\tSYNCED\tSTATUS")
+
+	for _, ch := range channels {
+		storage := ch.Storage
+		if storage == "" {
+			storage = "-"
+		}
+
+		status := "not archived"
+		switch {
+		case ch.MissingSince != nil:
+			status = "unavailable since " + ch.MissingSince.Local().Format("2006-01-02")
+		case !ch.SyncedAt.IsZero():
+			status = "archived"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t%s\n",
+			ch.ID, ch.Title, counts[ch.ID], storage, formatTime(ch.SyncedAt), status)
+	}
+
+	return tw.Flush()
+}
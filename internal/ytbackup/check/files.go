@@ -1,14 +1,12 @@
 package check
 
 import (
-	"crypto/sha256"
-	"fmt"
-	"io"
 	"os"
 	"path/filepath"
 
 	"github.com/rs/zerolog/log"
 	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/storages"
 	"mkuznets.com/go/ytbackup/internal/ytbackup"
 )
 
@@ -23,16 +21,34 @@ func (cmd *FilesCommand) Execute([]string) error {
 		sts[st.ID] = st.Path
 	}
 
-	err := cmd.Index.Iter(index.StatusDone, func(video *index.Video) error {
+	volumes, err := cmd.Index.Volumes()
+	if err != nil {
+		return err
+	}
+	lastPaths := make(map[string]string)
+	for _, v := range volumes {
+		lastPaths[v.ID] = v.Path
+	}
+
+	factor := cmd.Config.Replication.Factor
+	underReplicated := 0
+	offline := make(map[string]int)
+
+	err = cmd.Index.Iter(index.StatusDone, func(video *index.Video) error {
 		for _, st := range video.Storages {
 			path, ok := sts[st.ID]
 			if !ok {
+				offline[st.ID]++
 				continue
 			}
 			for _, f := range video.Files {
 				filePath := filepath.Join(path, f.Path)
 
 				fi, err := os.Stat(filePath)
+				if os.IsNotExist(err) {
+					log.Error().Str("id", video.ID).Str("storage", st.ID).Str("path", f.Path).Msg("file missing")
+					continue
+				}
 				if err != nil {
 					log.Err(err).Str("id", video.ID).Str("path", f.Path).Msg("could not stat file")
 					continue
@@ -49,31 +65,48 @@ func (cmd *FilesCommand) Execute([]string) error {
 				}
 
 				if cmd.Hashes {
-					fp, err := os.Open(filePath)
+					digest, err := storages.HashFile(filePath)
 					if err != nil {
-						log.Err(err).Str("id", video.ID).Str("path", f.Path).Msg("could not open file")
+						log.Err(err).Str("id", video.ID).Str("path", f.Path).Msg("could not hash file")
 						continue
 					}
 
-					h := sha256.New()
-					if _, err := io.Copy(h, fp); err != nil {
-						log.Err(err).Str("id", video.ID).Str("path", f.Path).Msg("copy error")
-						continue
-					}
-
-					digest := fmt.Sprintf("%x", h.Sum(nil))
-
 					if digest != f.Hash {
-						log.Err(err).Str("id", video.ID).Str("path", f.Path).Msg("hash does not match")
+						log.Error().Str("id", video.ID).Str("path", f.Path).Msg("hash does not match")
 					}
 				}
 			}
 		}
+
+		if len(video.Storages) < factor {
+			underReplicated++
+			log.Warn().
+				Str("id", video.ID).
+				Int("copies", len(video.Storages)).
+				Int("factor", factor).
+				Msg("video is under-replicated")
+		}
 		return nil
 	})
 	if err != nil {
 		return err
 	}
 
+	for id, n := range offline {
+		path, ok := lastPaths[id]
+		if !ok {
+			path = "unknown"
+		}
+		log.Warn().
+			Str("storage", id).
+			Str("last_path", path).
+			Int("videos", n).
+			Msg("Volume offline, files not checked")
+	}
+
+	if underReplicated > 0 {
+		log.Warn().Int("videos", underReplicated).Int("factor", factor).Msg("Under-replicated videos found")
+	}
+
 	return nil
 }
@@ -3,16 +3,17 @@ package ytbackup
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
-	"path/filepath"
 	"sync"
 	"syscall"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"mkuznets.com/go/ytbackup/internal/config"
+	"mkuznets.com/go/ytbackup/internal/control"
 	"mkuznets.com/go/ytbackup/internal/index"
 	"mkuznets.com/go/ytbackup/internal/storages"
 )
@@ -26,7 +27,7 @@ type Options struct {
 // Command is a common part of all subcommands.
 type Command struct {
 	DB          *sql.DB
-	Index       *index.Index
+	Index       control.Index
 	Storages    *storages.Storages
 	Config      *Config
 	Wg          *sync.WaitGroup
@@ -106,23 +107,53 @@ func (cmd *Command) Init(opts interface{}) error {
 
 	// -------------
 
-	sts := storages.New()
+	sts := storages.New(cmd.Config.Downloader.Placement)
 	for _, s := range cmd.Config.Storages {
-		sts.Add(s.Path)
+		sts.Add(
+			s.Path,
+			storages.WithReserved(uint64(s.Reserved)),
+			storages.WithMaxUsage(s.MaxUsage),
+			storages.WithChannels(s.Channels...),
+		)
 	}
 	cmd.Storages = sts
 
 	// -------------
 
-	idx := index.New(filepath.Join(cmd.Config.Dirs.Metadata(), "index.db"))
-	if err := idx.Init(); err != nil {
+	// The index database is locked by a running instance, use its control socket.
+	if client, err := control.Dial(cmd.Config.Dirs.ControlSocket()); err == nil {
+		log.Debug().Msg("Connected to the running ytbackup instance")
+		cmd.Index = client
+	} else {
+		idx := index.New(cmd.Config.Dirs.Index())
+		if err := idx.Init(); err != nil {
+			return err
+		}
+		cmd.Index = idx
+	}
+
+	// Known volumes are never replaced by new storages at their paths.
+	volumes, err := cmd.Index.Volumes()
+	if err != nil {
 		return err
 	}
-	cmd.Index = idx
+	for _, v := range volumes {
+		sts.Seen(v.Path)
+	}
 
 	return nil
 }
 
+// LocalIndex returns the index database opened by this process.
+// It fails if the database is owned by a running instance.
+func (cmd *Command) LocalIndex() (*index.Index, error) {
+	idx, ok := cmd.Index.(*index.Index)
+	if !ok {
+		return nil, errors.New("ytbackup is already running, stop it first")
+	}
+	return idx, nil
+}
+
 func (cmd *Command) Close() {
 	cmd.Wg.Wait()
 	if err := cmd.Index.Close(); err != nil {
@@ -5,25 +5,86 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"time"
 
 	"github.com/rs/zerolog/log"
 	"golang.org/x/oauth2"
 	"mkuznets.com/go/ytbackup/internal/appdirs"
 	"mkuznets.com/go/ytbackup/internal/browser"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/layout"
+	"mkuznets.com/go/ytbackup/internal/python"
+	"mkuznets.com/go/ytbackup/internal/sidecar"
+	"mkuznets.com/go/ytbackup/internal/storages"
 	"mkuznets.com/go/ytbackup/internal/utils"
 	"mkuznets.com/go/ytbackup/pkg/obscure"
 )
 
+var reChannelID = regexp.MustCompile(`^UC[\w-]{22}$`)
+
 const ConfigDefaults = `
 sources:
   update_interval: 5m
+  full_sync_interval: 168h
   max_duration: 9h
 
+youtube:
+  quota:
+    daily_budget: 10000
+    reserve: 2000
+
 browser:
   executable: chromium
   debug_port: 9222
 
+downloader:
+  workers: 1
+  placement: fill
+  layout: "{published:2006}/{published:01}/{published:20060102}_{id}/{id}"
+  profile: default
+  retry:
+    default:
+      max_attempts: 5
+      delay: 1m
+      max_delay: 6h
+      jitter: 0.2
+    network:
+      delay: 1m
+      max_delay: 1h
+      jitter: 0.2
+      horizon: 168h
+    disk-full:
+      delay: 5m
+      max_delay: 1h
+      horizon: 168h
+    system:
+      delay: 5m
+      max_delay: 1h
+    geo:
+      delay: 6h
+      max_delay: 48h
+      jitter: 0.2
+      horizon: 720h
+
+replication:
+  factor: 1
+  interval: 1h
+
+audit:
+  interval: 168h
+
+metadata:
+  refresh_interval: 720h
+
+comments:
+  enable: false
+  interval: 24h
+
+channel_archive:
+  enable: false
+  interval: 168h
+
 python:
   executable: python3
   youtube-dl:
@@ -38,16 +99,80 @@ type Config struct {
 			Enable bool
 		}
 		Playlists      map[string]string
+		Channels       Channels
 		UpdateInterval time.Duration `yaml:"update_interval"`
-		MaxDuration    time.Duration `yaml:"max_duration"`
+		// FullSyncInterval is a period of full playlist scans, zero disables them.
+		FullSyncInterval time.Duration `yaml:"full_sync_interval"`
+		MaxDuration      time.Duration `yaml:"max_duration"`
 	}
+	// Policies override settings for videos from matching sources.
+	Policies []*SourcePolicy
 	Dirs     Dirs
 	Storages []struct {
 		Path string
+		// Reserved is the space left free on the volume, e.g. "50GiB".
+		Reserved utils.ByteSize
+		// MaxUsage is the maximum usage of the volume in percent, zero means no limit.
+		MaxUsage float64 `yaml:"max_usage"`
+		// Channels are pinned to the storage with the `pin-by-channel` placement.
+		Channels []string
 	}
 	Youtube struct {
 		OAuth OAuth `yaml:"oauth"`
+		Quota struct {
+			// DailyBudget is the number of API units available per day.
+			DailyBudget int `yaml:"daily_budget"`
+			// Reserve is the part of the budget available only to high-priority calls.
+			Reserve int
+		}
+	}
+	Downloader struct {
+		Workers int
+		// Placement is a policy of storage selection for new downloads.
+		Placement storages.Policy
+		// Layout is a template of paths of downloaded files relative to the storage root.
+		Layout layout.Layout
+		// Profile is the name of the download profile of videos without a policy profile.
+		Profile string
+		// Profiles are named download settings. The built-in `default` profile keeps
+		// the defaults of youtube-dl options, `audio` keeps only the audio track in m4a
+		// with the thumbnail and metadata tags embedded.
+		Profiles map[string]*python.Profile
+		// Errors overrides actions for download failure reasons.
+		Errors map[python.Reason]ErrorAction
+		// Retry maps failure reasons to retry policies, "default" applies to the rest.
+		// A reason policy replaces the default one entirely.
+		Retry map[string]index.RetryPolicy
+	}
+	Replication struct {
+		// Factor is the number of storages each video is copied to.
+		Factor   int
+		Interval time.Duration
+	}
+	Audit struct {
+		// Interval is a period of availability checks of downloaded videos, zero disables them.
+		Interval time.Duration
+	}
+	Metadata struct {
+		// RefreshInterval is a period of metadata updates of downloaded videos, zero disables them.
+		RefreshInterval time.Duration `yaml:"refresh_interval"`
+	}
+	Comments struct {
+		// Enable archives comments of downloaded videos.
+		Enable bool
+		// Interval is a period of checks for new comments.
+		Interval time.Duration
+		// Playlists (titles from `sources.playlists`) and Channels (IDs) limit
+		// archiving to videos from these sources, all videos are archived by default.
+		Playlists []string
+		Channels  []string
 	}
+	ChannelArchive struct {
+		// Enable keeps identity, images and playlists of channels of downloaded videos.
+		Enable bool
+		// Interval is a period of channel archive updates.
+		Interval time.Duration
+	} `yaml:"channel_archive"`
 	Python struct {
 		Executable string `yaml:"executable"`
 		YoutubeDL  struct {
@@ -57,7 +182,44 @@ type Config struct {
 			Options        map[string]interface{}
 		} `yaml:"youtube-dl"`
 	}
-	Browser Browser
+	Browser  Browser
+	Sidecars struct {
+		// Enable writes .nfo files and posters for media servers next to downloaded videos.
+		Enable bool
+	}
+}
+
+// WriteSidecars creates media server metadata files of the video on the storage
+// at root and records them in the video files. Channel directories of the layout
+// get a tvshow.nfo file.
+func (cfg *Config) WriteSidecars(root string, video *index.Video) error {
+	files, err := sidecar.Write(root, video)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		video.SetFile(f)
+	}
+
+	if dir, ok := cfg.Downloader.Layout.ChannelDir(video.Meta); ok {
+		return sidecar.WriteShow(root, dir, video.Meta)
+	}
+	return nil
+}
+
+type Channels struct {
+	// Subscriptions enables crawling of all channels the user is subscribed to.
+	Subscriptions bool
+	// PublishedAfter is a default cut-off for videos from all channels.
+	PublishedAfter time.Time `yaml:"published_after"`
+	List           map[string]struct {
+		ID             string
+		PublishedAfter time.Time `yaml:"published_after"`
+	}
+}
+
+func (chs *Channels) Enabled() bool {
+	return chs.Subscriptions || len(chs.List) > 0
 }
 
 type Dirs struct {
@@ -73,6 +235,14 @@ func (dirs *Dirs) Metadata() string {
 	return filepath.Join(dirs.Data, "metadata")
 }
 
+func (dirs *Dirs) Index() string {
+	return filepath.Join(dirs.Metadata(), "index.db")
+}
+
+func (dirs *Dirs) ControlSocket() string {
+	return filepath.Join(dirs.Metadata(), "control.sock")
+}
+
 func (dirs *Dirs) Python() string {
 	return filepath.Join(dirs.Data, "python")
 }
@@ -165,9 +335,75 @@ func (cfg *Config) Validate() error {
 		}
 	}
 
+	for title, ch := range cfg.Sources.Channels.List {
+		if ch.ID == "" {
+			return fmt.Errorf("`sources.channels.list.%s.id` is required", title)
+		}
+	}
+
 	if err := cfg.validateStorages(); err != nil {
 		return err
 	}
+
+	if cfg.Downloader.Workers < 1 {
+		return errors.New("`downloader.workers` must be at least 1")
+	}
+
+	if cfg.Replication.Factor < 1 {
+		return errors.New("`replication.factor` must be at least 1")
+	}
+	if cfg.Replication.Factor > 1 && cfg.Replication.Interval <= 0 {
+		return errors.New("`replication.interval` must be positive")
+	}
+
+	if cfg.Audit.Interval < 0 {
+		return errors.New("`audit.interval` must not be negative")
+	}
+	if cfg.Metadata.RefreshInterval < 0 {
+		return errors.New("`metadata.refresh_interval` must not be negative")
+	}
+
+	if cfg.ChannelArchive.Enable && cfg.ChannelArchive.Interval <= 0 {
+		return errors.New("`channel_archive.interval` must be positive")
+	}
+
+	if err := cfg.validateComments(); err != nil {
+		return err
+	}
+
+	if err := cfg.validateProfiles(); err != nil {
+		return err
+	}
+	if err := cfg.validatePolicies(); err != nil {
+		return err
+	}
+
+	if err := cfg.validateErrorActions(); err != nil {
+		return err
+	}
+	if err := cfg.validateRetryPolicies(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (cfg *Config) validateComments() error {
+	if !cfg.Comments.Enable {
+		return nil
+	}
+	if cfg.Comments.Interval <= 0 {
+		return errors.New("`comments.interval` must be positive")
+	}
+	for _, title := range cfg.Comments.Playlists {
+		if _, ok := cfg.Sources.Playlists[title]; !ok {
+			return fmt.Errorf("`comments.playlists`: playlist %q is not in `sources.playlists`", title)
+		}
+	}
+	for _, id := range cfg.Comments.Channels {
+		if !reChannelID.MatchString(id) {
+			return fmt.Errorf("`comments.channels`: %q is not a channel ID", id)
+		}
+	}
 	return nil
 }
 
@@ -176,6 +412,10 @@ func (cfg *Config) validateYoutube() error {
 	if oauth.AccessToken == "" || oauth.RefreshToken == "" || oauth.TokenType == "" {
 		return errors.New("oauth.{access_token, token_type, refresh_token} are required")
 	}
+	quota := cfg.Youtube.Quota
+	if quota.DailyBudget <= 0 || quota.Reserve < 0 || quota.Reserve >= quota.DailyBudget {
+		return errors.New("quota.daily_budget must be positive and greater than quota.reserve")
+	}
 	return nil
 }
 
@@ -202,7 +442,15 @@ func (cfg *Config) validateStorages() (err error) {
 
 	for i, st := range cfg.Storages {
 		cfg.Storages[i].Path = utils.MustExpand(st.Path)
+		if st.MaxUsage < 0 || st.MaxUsage > 100 {
+			return fmt.Errorf("`storages[%d].max_usage` must be between 0 and 100", i)
+		}
 	}
 
-	return nil
+	for _, policy := range storages.Policies {
+		if cfg.Downloader.Placement == policy {
+			return nil
+		}
+	}
+	return fmt.Errorf("`downloader.placement`: unknown policy %q, expected one of %v", cfg.Downloader.Placement, storages.Policies)
 }
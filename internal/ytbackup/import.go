@@ -8,6 +8,7 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/mitchellh/go-homedir"
+	"mkuznets.com/go/ytbackup/internal/index"
 	"mkuznets.com/go/ytbackup/internal/utils"
 )
 
@@ -54,7 +55,7 @@ func (cmd *ImportCommand) Execute([]string) error {
 		return fmt.Errorf("import error: %v", err)
 	}
 
-	n, err := cmd.Index.Push(ids)
+	n, err := cmd.Index.Push(index.Source{Kind: index.SourceImport}, ids)
 	if err != nil {
 		return err
 	}
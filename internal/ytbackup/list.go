@@ -2,16 +2,25 @@ package ytbackup
 
 import (
 	"os"
+	"sort"
+	"strings"
 
 	"mkuznets.com/go/ytbackup/internal/format"
 	"mkuznets.com/go/ytbackup/internal/index"
 )
 
 type ListCommand struct {
-	Status  string `short:"s" long:"status" description:"Filter videos by status. Valid options: NEW, ENQUEUED, DONE, INPROGRESS, FAILED, SKIPPED."`
-	JSON    bool   `long:"json" description:"JSON output"`
-	NoTrunc bool   `long:"no-trunc" description:"Don't truncate output"`
+	Status   string `short:"s" long:"status" description:"Filter videos by status. Valid options: NEW, ENQUEUED, DONE, INPROGRESS, FAILED, SKIPPED, SCHEDULED, RETIRING."`
+	Rescued  bool   `long:"rescued" description:"Show only downloaded videos that are no longer available on Youtube"`
+	Source   string `long:"source" description:"Filter videos by source: history, import, manual, playlist[:TITLE|ID], channel[:TITLE|ID]"`
+	Category string `long:"category" description:"Filter videos by category name (case-insensitive)"`
+	Language string `long:"language" description:"Filter videos by language code"`
+	Captions bool   `long:"captions" description:"Show only videos with creator captions"`
+	Sort     string `long:"sort" choice:"published" choice:"duration" choice:"views" choice:"likes" description:"Sort videos in descending order"`
+	JSON     bool   `long:"json" description:"JSON output"`
+	NoTrunc  bool   `long:"no-trunc" description:"Don't truncate output"`
 	Command
+	source *index.Source
 }
 
 func (cmd *ListCommand) Execute([]string) error {
@@ -24,13 +33,102 @@ func (cmd *ListCommand) Execute([]string) error {
 		f = format.NewTable(os.Stdout, cmd.NoTrunc)
 	}
 
-	if err := cmd.Index.Iter(status, f.Put); err != nil {
+	if cmd.Rescued {
+		status = index.StatusDone
+	}
+
+	if cmd.Source != "" {
+		src, err := cmd.Config.ResolveSource(cmd.Source)
+		if err != nil {
+			return err
+		}
+		cmd.source = &src
+	}
+
+	videos := make([]*index.Video, 0)
+	put := func(video *index.Video) error {
+		if !cmd.match(video) {
+			return nil
+		}
+		if cmd.Sort != "" {
+			videos = append(videos, video)
+			return nil
+		}
+		return f.Put(video)
+	}
+
+	if err := cmd.Index.Iter(status, put); err != nil {
 		return err
 	}
 
+	if cmd.Sort != "" {
+		key := sortKeys[cmd.Sort]
+		sort.SliceStable(videos, func(i, j int) bool {
+			return key(videos[i]) > key(videos[j])
+		})
+		for _, video := range videos {
+			if err := f.Put(video); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := f.Flush(); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (cmd *ListCommand) match(video *index.Video) bool {
+	if cmd.Rescued && !video.Rescued() {
+		return false
+	}
+	if cmd.source != nil && !video.HasSource(*cmd.source) {
+		return false
+	}
+
+	meta := video.Meta
+	if meta == nil {
+		meta = &index.Meta{}
+	}
+	if cmd.Category != "" && !strings.EqualFold(meta.Category, cmd.Category) {
+		return false
+	}
+	if cmd.Language != "" && !strings.EqualFold(meta.Language, cmd.Language) &&
+		!strings.HasPrefix(strings.ToLower(meta.Language), strings.ToLower(cmd.Language)+"-") {
+		return false
+	}
+	if cmd.Captions && !meta.Captions {
+		return false
+	}
+
+	return true
+}
+
+var sortKeys = map[string]func(*index.Video) int64{
+	"published": func(v *index.Video) int64 {
+		if v.Meta == nil || v.Meta.PublishedAt.IsZero() {
+			return 0
+		}
+		return v.Meta.PublishedAt.Unix()
+	},
+	"duration": func(v *index.Video) int64 {
+		if v.Meta == nil {
+			return 0
+		}
+		return int64(v.Meta.Duration)
+	},
+	"views": func(v *index.Video) int64 {
+		if v.Meta == nil || v.Meta.Statistics == nil {
+			return 0
+		}
+		return int64(v.Meta.Statistics.Views)
+	},
+	"likes": func(v *index.Video) int64 {
+		if v.Meta == nil || v.Meta.Statistics == nil {
+			return 0
+		}
+		return int64(v.Meta.Statistics.Likes)
+	},
+}
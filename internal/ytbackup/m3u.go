@@ -0,0 +1,137 @@
+package ytbackup
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/layout"
+)
+
+type M3UCommand struct {
+	Output   string `short:"o" long:"output" required:"1" value-name:"DIR" description:"Directory to write playlists to"`
+	Relative bool   `long:"relative" description:"Write paths relative to the output directory"`
+	Removed  bool   `long:"removed" description:"Include videos removed from playlists"`
+	Command
+}
+
+func (cmd *M3UCommand) Execute([]string) error {
+	output, err := filepath.Abs(cmd.Output)
+	if err != nil {
+		return err
+	}
+	cmd.Output = output
+
+	if err := os.MkdirAll(cmd.Output, 0755); err != nil {
+		return err
+	}
+
+	roots := make(map[string]string)
+	for _, r := range cmd.Storages.List() {
+		roots[r.ID] = r.Path
+	}
+
+	for title, playlistID := range cmd.Config.Sources.Playlists {
+		members, err := cmd.Index.Members(playlistID)
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(cmd.Output, layout.Sanitise(title)+".m3u")
+		written, missing, err := cmd.writePlaylist(path, members, roots)
+		if err != nil {
+			return fmt.Errorf("could not write playlist `%s`: %v", title, err)
+		}
+
+		log.Info().
+			Str("playlist", title).
+			Str("path", path).
+			Int("videos", written).
+			Int("missing", missing).
+			Msg("Playlist exported")
+	}
+
+	return nil
+}
+
+// writePlaylist writes an extended M3U file with local paths of the playlist videos.
+// Videos without an online copy are written as comments.
+func (cmd *M3UCommand) writePlaylist(path string, members []*index.Member, roots map[string]string) (written, missing int, err error) {
+	fp, err := os.Create(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer fp.Close()
+
+	w := bufio.NewWriter(fp)
+	fmt.Fprintln(w, "#EXTM3U")
+
+	for _, m := range members {
+		if m.RemovedAt != nil && !cmd.Removed {
+			continue
+		}
+
+		video, err := cmd.Index.Find(m.VideoID)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		entry := m.VideoID
+		duration := -1
+		if video != nil && video.Meta != nil {
+			entry = fmt.Sprintf("%s - %s", video.Meta.ChannelTitle, video.Meta.Title)
+			if video.Meta.Duration > 0 {
+				duration = video.Meta.Duration
+			}
+		}
+
+		local := ""
+		if video != nil {
+			local = cmd.localPath(video, roots)
+		}
+		if local == "" {
+			fmt.Fprintf(w, "# missing: %s %s\n", m.VideoID, entry)
+			missing++
+			continue
+		}
+
+		fmt.Fprintf(w, "#EXTINF:%d,%s\n%s\n", duration, entry, local)
+		written++
+	}
+
+	if err := w.Flush(); err != nil {
+		return 0, 0, err
+	}
+	return written, missing, fp.Close()
+}
+
+// localPath returns the path of the media file of the video on an online storage.
+func (cmd *M3UCommand) localPath(video *index.Video, roots map[string]string) string {
+	media, ok := video.Media()
+	if !ok {
+		return ""
+	}
+
+	for _, st := range video.Storages {
+		root, ok := roots[st.ID]
+		if !ok {
+			continue
+		}
+
+		path := filepath.Join(root, media.Path)
+		if cmd.Relative {
+			if rel, err := filepath.Rel(cmd.Output, path); err == nil {
+				return rel
+			}
+		}
+		if abs, err := filepath.Abs(path); err == nil {
+			return abs
+		}
+		return path
+	}
+
+	return ""
+}
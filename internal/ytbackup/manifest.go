@@ -0,0 +1,59 @@
+package ytbackup
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/control"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/storages"
+)
+
+// WriteManifests rewrites the manifests of the given storages with the videos
+// the index lists on them. written maps storage IDs to digests of the manifests
+// written before, so that unchanged ones are not rewritten; it may be nil.
+func WriteManifests(idx control.Index, ready []*storages.Ready, written map[string]string) error {
+	contents := make(map[string][]storages.ManifestVideo)
+	err := idx.Iter(index.StatusDone, func(video *index.Video) error {
+		for _, st := range video.Storages {
+			contents[st.ID] = append(contents[st.ID], storages.ManifestVideo{ID: video.ID, Files: video.Files})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, r := range ready {
+		videos := contents[r.ID]
+		if videos == nil {
+			videos = make([]storages.ManifestVideo, 0)
+		}
+
+		data, err := json.Marshal(videos)
+		if err != nil {
+			return err
+		}
+		digest := fmt.Sprintf("%x", sha256.Sum256(data))
+		if written != nil && written[r.ID] == digest {
+			continue
+		}
+
+		m := &storages.Manifest{StorageID: r.ID, UpdatedAt: now, Videos: videos}
+		if err := storages.WriteManifest(r.Path, m); err != nil {
+			log.Err(err).Str("storage", r.ID).Msg("Could not write manifest")
+			continue
+		}
+		if written != nil {
+			written[r.ID] = digest
+		}
+
+		log.Debug().Str("storage", r.ID).Int("videos", len(videos)).Msg("Manifest updated")
+	}
+
+	return nil
+}
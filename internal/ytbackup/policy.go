@@ -0,0 +1,255 @@
+package ytbackup
+
+import (
+	"fmt"
+	"time"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/python"
+)
+
+// ErrorAction defines how the downloader handles a failed download.
+type ErrorAction string
+
+const (
+	// ActionRetry schedules a retry according to the retry policy of the reason.
+	ActionRetry ErrorAction = "retry"
+	// ActionFail marks the video as failed.
+	ActionFail ErrorAction = "fail"
+	// ActionPause schedules a retry like ActionRetry and also pauses the worker for a while.
+	ActionPause ErrorAction = "pause"
+)
+
+var defaultErrorActions = map[python.Reason]ErrorAction{
+	python.ReasonPrivate:         ActionFail,
+	python.ReasonRemoved:         ActionFail,
+	python.ReasonCopyright:       ActionFail,
+	python.ReasonGeo:             ActionRetry,
+	python.ReasonAgeGate:         ActionFail,
+	python.ReasonMembersOnly:     ActionFail,
+	python.ReasonPremiere:        ActionRetry,
+	python.ReasonNetwork:         ActionPause,
+	python.ReasonDiskFull:        ActionPause,
+	python.ReasonNoStorage:       ActionFail,
+	python.ReasonSystem:          ActionPause,
+	python.ReasonExtractorBroken: ActionRetry,
+	python.ReasonUnknown:         ActionRetry,
+}
+
+const defaultRetryPolicy = "default"
+
+// builtinProfiles are available unless the config defines profiles with the same names.
+var builtinProfiles = map[string]*python.Profile{
+	"default": {},
+	"audio":   {Audio: "m4a"},
+}
+
+// ErrorAction returns the action for a failure reason, config overrides take precedence.
+func (cfg *Config) ErrorAction(reason python.Reason) ErrorAction {
+	if action, ok := cfg.Downloader.Errors[reason]; ok {
+		return action
+	}
+	if action, ok := defaultErrorActions[reason]; ok {
+		return action
+	}
+	return ActionRetry
+}
+
+func (cfg *Config) validateErrorActions() error {
+	for reason, action := range cfg.Downloader.Errors {
+		if _, ok := defaultErrorActions[reason]; !ok {
+			return fmt.Errorf("`downloader.errors`: unknown reason %q, expected one of %v", reason, python.Reasons)
+		}
+		switch action {
+		case ActionRetry, ActionFail, ActionPause:
+		default:
+			return fmt.Errorf("`downloader.errors.%s`: unknown action %q, expected retry, fail or pause", reason, action)
+		}
+	}
+	return nil
+}
+
+// RetryPolicy returns the retry policy for a failure reason.
+func (cfg *Config) RetryPolicy(reason python.Reason) index.RetryPolicy {
+	if policy, ok := cfg.Downloader.Retry[string(reason)]; ok {
+		return policy
+	}
+	return cfg.Downloader.Retry[defaultRetryPolicy]
+}
+
+func (cfg *Config) validateRetryPolicies() error {
+	for name, policy := range cfg.Downloader.Retry {
+		if _, ok := defaultErrorActions[python.Reason(name)]; !ok && name != defaultRetryPolicy {
+			return fmt.Errorf("`downloader.retry`: unknown reason %q, expected `default` or one of %v", name, python.Reasons)
+		}
+		if policy.MaxAttempts < 0 || policy.Delay < 0 || policy.MaxDelay < 0 || policy.Horizon < 0 {
+			return fmt.Errorf("`downloader.retry.%s`: values must not be negative", name)
+		}
+		if policy.Jitter < 0 || policy.Jitter > 1 {
+			return fmt.Errorf("`downloader.retry.%s.jitter` must be between 0 and 1", name)
+		}
+	}
+	return nil
+}
+
+// SourcePolicy overrides settings for videos found from matching sources.
+// The first policy matching a source applies to it.
+type SourcePolicy struct {
+	// Source is a source kind optionally followed by a playlist or a channel
+	// (title from the config or ID), e.g. `history` or `playlist:Music`.
+	Source string
+	// MaxDuration overrides `sources.max_duration`.
+	MaxDuration time.Duration `yaml:"max_duration"`
+	// Retention is the time downloaded videos are kept after they have been
+	// first seen from the source, zero keeps them forever.
+	Retention time.Duration
+	// Profile is the name of the download profile from `downloader.profiles`.
+	Profile string
+
+	pattern index.Source
+}
+
+// ResolveSource parses a source in the `kind[:id]` form, playlist
+// and channel titles from the config are replaced with their IDs.
+func (cfg *Config) ResolveSource(s string) (index.Source, error) {
+	src, err := index.ParseSource(s)
+	if err != nil {
+		return src, err
+	}
+
+	switch src.Kind {
+	case index.SourcePlaylist:
+		if id, ok := cfg.Sources.Playlists[src.ID]; ok {
+			src.ID = id
+		}
+	case index.SourceChannel:
+		if ch, ok := cfg.Sources.Channels.List[src.ID]; ok {
+			src.ID = ch.ID
+		}
+	}
+	return src, nil
+}
+
+func (cfg *Config) policyFor(src index.Source) *SourcePolicy {
+	for _, p := range cfg.Policies {
+		if src.Matches(p.pattern) {
+			return p
+		}
+	}
+	return nil
+}
+
+// MaxDuration returns the maximum duration of the video to be downloaded.
+// A video from several sources gets the most permissive limit.
+func (cfg *Config) MaxDuration(video *index.Video) time.Duration {
+	if len(video.Sources) == 0 {
+		return cfg.Sources.MaxDuration
+	}
+
+	var max time.Duration
+	for _, src := range video.Sources {
+		d := cfg.Sources.MaxDuration
+		if p := cfg.policyFor(src); p != nil && p.MaxDuration > 0 {
+			d = p.MaxDuration
+		}
+		if d > max {
+			max = d
+		}
+	}
+	return max
+}
+
+// Expired reports whether the retention period of every source of the video is over.
+func (cfg *Config) Expired(video *index.Video, now time.Time) bool {
+	if len(video.Sources) == 0 {
+		return false
+	}
+
+	for _, src := range video.Sources {
+		p := cfg.policyFor(src)
+		if p == nil || p.Retention == 0 || src.FirstSeen.Add(p.Retention).After(now) {
+			return false
+		}
+	}
+	return true
+}
+
+// Profile returns the name and settings of the download profile of the video.
+// Policies are checked in order, the first one with a profile matching any
+// source of the video wins, so more specific sources should be listed first.
+func (cfg *Config) Profile(video *index.Video) (string, *python.Profile) {
+	for _, p := range cfg.Policies {
+		if p.Profile == "" {
+			continue
+		}
+		for _, src := range video.Sources {
+			if src.Matches(p.pattern) {
+				return p.Profile, cfg.Downloader.Profiles[p.Profile]
+			}
+		}
+	}
+	name := cfg.Downloader.Profile
+	return name, cfg.Downloader.Profiles[name]
+}
+
+// YDLOptions returns youtube-dl options of the download profile of the video.
+// Profile settings take precedence over `python.youtube-dl.options`.
+func (cfg *Config) YDLOptions(video *index.Video) (string, map[string]interface{}) {
+	name, profile := cfg.Profile(video)
+	return name, profile.YDLOptions(cfg.Python.YoutubeDL.Options)
+}
+
+// HasRetention reports whether any policy removes videos.
+func (cfg *Config) HasRetention() bool {
+	for _, p := range cfg.Policies {
+		if p.Retention > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (cfg *Config) validatePolicies() error {
+	for i, p := range cfg.Policies {
+		pattern, err := cfg.ResolveSource(p.Source)
+		if err != nil {
+			return fmt.Errorf("`policies[%d].source`: %v", i, err)
+		}
+		p.pattern = pattern
+
+		if p.MaxDuration < 0 || p.Retention < 0 {
+			return fmt.Errorf("`policies[%d]`: values must not be negative", i)
+		}
+		if _, ok := cfg.Downloader.Profiles[p.Profile]; p.Profile != "" && !ok {
+			return fmt.Errorf("`policies[%d].profile`: unknown profile %q", i, p.Profile)
+		}
+	}
+	return nil
+}
+
+func (cfg *Config) validateProfiles() error {
+	if cfg.Downloader.Profiles == nil {
+		cfg.Downloader.Profiles = make(map[string]*python.Profile)
+	}
+	for name, profile := range builtinProfiles {
+		if _, ok := cfg.Downloader.Profiles[name]; !ok {
+			p := *profile
+			cfg.Downloader.Profiles[name] = &p
+		}
+	}
+
+	for name, profile := range cfg.Downloader.Profiles {
+		if profile == nil {
+			profile = &python.Profile{}
+			cfg.Downloader.Profiles[name] = profile
+		}
+		if err := profile.Validate(); err != nil {
+			return fmt.Errorf("`downloader.profiles.%s`: %v", name, err)
+		}
+	}
+
+	if _, ok := cfg.Downloader.Profiles[cfg.Downloader.Profile]; !ok {
+		return fmt.Errorf("`downloader.profile`: unknown profile %q", cfg.Downloader.Profile)
+	}
+	return nil
+}
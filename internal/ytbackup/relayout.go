@@ -0,0 +1,207 @@
+package ytbackup
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/layout"
+	"mkuznets.com/go/ytbackup/internal/sidecar"
+	"mkuznets.com/go/ytbackup/internal/storages"
+)
+
+type RelayoutCommand struct {
+	Layout string `long:"layout" description:"Path template (default: downloader.layout from config)"`
+	DryRun bool   `long:"dry-run" description:"Only show what would be moved"`
+	Command
+}
+
+func (cmd *RelayoutCommand) Execute([]string) error {
+	l := &cmd.Config.Downloader.Layout
+	if cmd.Layout != "" {
+		parsed, err := layout.Parse(cmd.Layout)
+		if err != nil {
+			return err
+		}
+		l = parsed
+	}
+
+	idx, err := cmd.LocalIndex()
+	if err != nil {
+		return err
+	}
+
+	ready := cmd.Storages.List()
+	roots := make(map[string]string)
+	for _, r := range ready {
+		roots[r.ID] = r.Path
+	}
+
+	videos := make([]*index.Video, 0)
+	err = idx.Iter(index.StatusDone, func(video *index.Video) error {
+		videos = append(videos, video)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	moved, skipped, failed := 0, 0, 0
+
+	for _, video := range videos {
+		if cmd.Ctx.Err() != nil {
+			break
+		}
+
+		files, ok := relocate(video, l)
+		if !ok {
+			log.Warn().Str("id", video.ID).Msg("Could not find info.json, video skipped")
+			skipped++
+			continue
+		}
+		if sameFiles(files, video.Files) {
+			continue
+		}
+
+		offline := false
+		for _, st := range video.Storages {
+			if _, ok := roots[st.ID]; !ok {
+				log.Warn().Str("id", video.ID).Str("storage", st.ID).Msg("Storage is offline, video skipped")
+				offline = true
+			}
+		}
+		if offline {
+			skipped++
+			continue
+		}
+
+		log.Info().
+			Str("id", video.ID).
+			Str("path", files[0].Path).
+			Bool("dry_run", cmd.DryRun).
+			Msg("Moving video files")
+
+		if cmd.DryRun {
+			moved++
+			continue
+		}
+
+		if err := renameFiles(roots, video, files); err != nil {
+			log.Err(err).Str("id", video.ID).Msg("Could not move video files")
+			failed++
+			continue
+		}
+
+		old := video.Files
+		video.Files = files
+		if err := idx.Put(video); err != nil {
+			return err
+		}
+
+		// Only empty directories are left at the old paths
+		for _, st := range video.Storages {
+			if err := storages.RemoveFiles(roots[st.ID], movedFiles(old, files)); err != nil {
+				log.Err(err).Str("id", video.ID).Msg("Could not clean up old directories")
+			}
+		}
+		moved++
+	}
+
+	log.Info().Int("moved", moved).Int("skipped", skipped).Int("failed", failed).Msg("Relayout done")
+
+	if moved > 0 && !cmd.DryRun {
+		if err := WriteManifests(idx, ready, nil); err != nil {
+			log.Err(err).Msg("Could not update manifests")
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d videos could not be moved, run the command again to retry", failed)
+	}
+	return nil
+}
+
+// relocate returns the files of the video at the paths defined by the layout.
+// The current name of the video files is determined by its info.json file.
+func relocate(video *index.Video, l *layout.Layout) ([]index.File, bool) {
+	stem, ok := video.Stem()
+	if !ok {
+		return nil, false
+	}
+
+	newStem := l.Path(video.ID, video.Meta)
+
+	files := make([]index.File, 0, len(video.Files))
+	for _, f := range video.Files {
+		switch {
+		case f.Path == sidecar.PosterPath(stem, video.ID):
+			f.Path = sidecar.PosterPath(newStem, video.ID)
+		case strings.HasPrefix(f.Path, stem):
+			f.Path = newStem + strings.TrimPrefix(f.Path, stem)
+		default:
+			f.Path = filepath.Join(filepath.Dir(newStem), filepath.Base(f.Path))
+		}
+		files = append(files, f)
+	}
+
+	return files, true
+}
+
+func sameFiles(a, b []index.File) bool {
+	for i := range a {
+		if a[i].Path != b[i].Path {
+			return false
+		}
+	}
+	return true
+}
+
+// movedFiles returns the old files whose paths are not used by the new ones.
+func movedFiles(old, files []index.File) []index.File {
+	kept := make(map[string]struct{}, len(files))
+	for _, f := range files {
+		kept[f.Path] = struct{}{}
+	}
+
+	moved := make([]index.File, 0, len(old))
+	for _, f := range old {
+		if _, ok := kept[f.Path]; !ok {
+			moved = append(moved, f)
+		}
+	}
+	return moved
+}
+
+// renameFiles moves the files of the video on all its storages.
+// Files that have already been moved by an interrupted run are kept.
+func renameFiles(roots map[string]string, video *index.Video, files []index.File) error {
+	for _, st := range video.Storages {
+		root := roots[st.ID]
+
+		for i, f := range files {
+			src := filepath.Join(root, video.Files[i].Path)
+			dst := filepath.Join(root, f.Path)
+			if src == dst {
+				continue
+			}
+
+			if _, err := os.Stat(dst); err == nil {
+				if _, err := os.Stat(src); os.IsNotExist(err) {
+					continue
+				}
+				return fmt.Errorf("%s already exists on storage %s", f.Path, st.ID)
+			}
+
+			if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+				return err
+			}
+			if err := os.Rename(src, dst); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package ytbackup
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/layout"
+	"mkuznets.com/go/ytbackup/internal/storages"
+)
+
+func TestRelayoutKeepsUnchangedPaths(t *testing.T) {
+	root, err := ioutil.TempDir("", "relayout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+
+	video := &index.Video{
+		ID:       "abc",
+		Storages: []index.Storage{{ID: "st"}},
+		Meta:     &index.Meta{Title: "Title", PublishedAt: time.Date(2020, 8, 11, 0, 0, 0, 0, time.UTC)},
+		Files: []index.File{
+			{Path: filepath.FromSlash("2020/abc/abc.info.json")},
+			{Path: filepath.FromSlash("2020/abc/abc.mkv")},
+			{Path: filepath.FromSlash("2020/abc/poster.jpg")},
+		},
+	}
+	for _, f := range video.Files {
+		path := filepath.Join(root, f.Path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(f.Path), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, ok := relocate(video, layout.MustParse("{published:2006}/{id}/{title} [{id}]"))
+	if !ok {
+		t.Fatal("relocate: could not find info.json")
+	}
+	if files[2].Path != video.Files[2].Path {
+		t.Fatalf("expected the poster path to be unchanged, got %q", files[2].Path)
+	}
+
+	roots := map[string]string{"st": root}
+	if err := renameFiles(roots, video, files); err != nil {
+		t.Fatal(err)
+	}
+	if err := storages.RemoveFiles(root, movedFiles(video.Files, files)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, f := range files {
+		if _, err := os.Stat(filepath.Join(root, f.Path)); err != nil {
+			t.Errorf("expected %s to exist: %v", f.Path, err)
+		}
+	}
+	for _, f := range video.Files[:2] {
+		if _, err := os.Stat(filepath.Join(root, f.Path)); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be moved", f.Path)
+		}
+	}
+}
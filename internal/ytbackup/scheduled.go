@@ -0,0 +1,63 @@
+package ytbackup
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"mkuznets.com/go/tabwriter"
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+type ScheduledCommand struct {
+	Command
+}
+
+func (cmd *ScheduledCommand) Execute([]string) error {
+	videos := make([]*index.Video, 0)
+
+	err := cmd.Index.Iter(index.StatusScheduled, func(video *index.Video) error {
+		videos = append(videos, video)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sort.SliceStable(videos, func(i, j int) bool {
+		return scheduledAt(videos[i]).Before(scheduledAt(videos[j]))
+	})
+
+	now := time.Now()
+	tw := tabwriter.NewWriter(os.Stdout, 10, 1, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tCHECK AT\tIN\tCHANNEL\tTITLE")
+
+	for _, video := range videos {
+		at := scheduledAt(video)
+
+		in := "-"
+		if !at.IsZero() {
+			in = at.Sub(now).Truncate(time.Minute).String()
+			if at.Before(now) {
+				in = "due"
+			}
+		}
+
+		var channel, title string
+		if video.Meta != nil {
+			channel, title = video.Meta.ChannelTitle, video.Meta.Title
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", video.ID, formatTime(at), in, channel, title)
+	}
+
+	return tw.Flush()
+}
+
+func scheduledAt(video *index.Video) time.Time {
+	if video.ScheduledAt == nil {
+		return time.Time{}
+	}
+	return *video.ScheduledAt
+}
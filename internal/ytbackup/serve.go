@@ -0,0 +1,54 @@
+package ytbackup
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/control"
+	"mkuznets.com/go/ytbackup/internal/web"
+)
+
+type ServeCommand struct {
+	Listen string `short:"l" long:"listen" default:"127.0.0.1:8008" description:"Address to listen on"`
+	Command
+}
+
+func (cmd *ServeCommand) Execute([]string) error {
+	// Do not keep the index locked for the lifetime of the server,
+	// `ytbackup start` may be launched at any time.
+	if err := cmd.Index.Close(); err != nil {
+		return err
+	}
+	cmd.Index = control.NewReader(cmd.Config.Dirs.ControlSocket(), cmd.Config.Dirs.Index())
+
+	handler, err := web.New(cmd.Index, cmd.Storages)
+	if err != nil {
+		return err
+	}
+
+	srv := &http.Server{
+		Addr:    cmd.Listen,
+		Handler: handler,
+	}
+
+	go func() {
+		<-cmd.Ctx.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Err(err).Msg("Server shutdown")
+		}
+	}()
+
+	log.Info().Str("addr", cmd.Listen).Msg("HTTP server: listening")
+
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	log.Info().Msg("HTTP server stopped")
+	return nil
+}
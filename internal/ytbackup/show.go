@@ -0,0 +1,144 @@
+package ytbackup
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"mkuznets.com/go/tabwriter"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/utils"
+)
+
+type ShowCommand struct {
+	History bool `long:"history" description:"Show changes between known versions of the metadata"`
+	Command
+	Args struct {
+		ID string `positional-arg-name:"ID" required:"1"`
+	} `positional-args:"1"`
+}
+
+func (cmd *ShowCommand) Execute([]string) error {
+	video, err := cmd.Index.Find(cmd.Args.ID)
+	if err != nil {
+		return err
+	}
+	if video == nil {
+		return fmt.Errorf("video %s not found", cmd.Args.ID)
+	}
+
+	if cmd.History {
+		return cmd.showHistory(video)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 10, 1, 2, ' ', 0)
+	fmt.Fprintf(tw, "ID\t%s\n", video.ID)
+	fmt.Fprintf(tw, "STATUS\t%s\n", video.Status)
+	if video.Reason != "" {
+		fmt.Fprintf(tw, "REASON\t%s\n", video.Reason)
+	}
+	if meta := video.Meta; meta != nil {
+		fmt.Fprintf(tw, "TITLE\t%s\n", meta.Title)
+		fmt.Fprintf(tw, "CHANNEL\t%s (%s)\n", meta.ChannelTitle, meta.ChannelID)
+		fmt.Fprintf(tw, "PUBLISHED\t%s\n", formatTime(meta.PublishedAt))
+		if meta.Duration > 0 {
+			fmt.Fprintf(tw, "DURATION\t%s\n", utils.FormatDuration(meta.Duration))
+		}
+		if meta.Category != "" {
+			fmt.Fprintf(tw, "CATEGORY\t%s\n", meta.Category)
+		}
+		if meta.Language != "" {
+			fmt.Fprintf(tw, "LANGUAGE\t%s\n", meta.Language)
+		}
+		if meta.License != "" {
+			fmt.Fprintf(tw, "LICENSE\t%s\n", meta.License)
+		}
+		fmt.Fprintf(tw, "CAPTIONS\t%v\n", meta.Captions)
+		if st := meta.Statistics; st != nil {
+			fmt.Fprintf(tw, "STATISTICS\t%d views, %d likes, %d comments\n", st.Views, st.Likes, st.Comments)
+		}
+		if len(meta.Tags) > 0 {
+			fmt.Fprintf(tw, "TAGS\t%s\n", strings.Join(meta.Tags, ", "))
+		}
+	}
+	if video.RefreshedAt != nil {
+		fmt.Fprintf(tw, "REFRESHED\t%s\n", formatTime(*video.RefreshedAt))
+	}
+	if u := video.Unavailable; u != nil {
+		fmt.Fprintf(tw, "UNAVAILABLE\tsince %s: %s\n", formatTime(u.Since), u.Reason)
+	}
+	for _, src := range video.Sources {
+		fmt.Fprintf(tw, "SOURCE\t%s (first seen %s)\n", src, formatTime(src.FirstSeen))
+	}
+	if video.Profile != "" {
+		fmt.Fprintf(tw, "PROFILE\t%s\n", video.Profile)
+	}
+	if media := video.MediaType(); media != "" {
+		fmt.Fprintf(tw, "MEDIA\t%s\n", media)
+	}
+	for _, st := range video.Storages {
+		fmt.Fprintf(tw, "STORAGE\t%s\n", st.ID)
+	}
+	for _, f := range video.Files {
+		fmt.Fprintf(tw, "FILE\t%s (%s)\n", f.Path, utils.IBytes(f.Size))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if video.Meta != nil && video.Meta.Description != "" {
+		fmt.Printf("\n%s\n", video.Meta.Description)
+	}
+
+	return nil
+}
+
+// showHistory prints the first known version of the metadata followed by
+// the changes of each subsequent version.
+func (cmd *ShowCommand) showHistory(video *index.Video) error {
+	versions, err := cmd.Index.History(video.ID)
+	if err != nil {
+		return err
+	}
+	if len(versions) == 0 {
+		if video.Meta == nil {
+			return errors.New("video has no metadata")
+		}
+		versions = []*index.Meta{video.Meta}
+	}
+
+	prev := &index.Meta{}
+	for i, meta := range versions {
+		if i > 0 {
+			fmt.Println()
+		}
+		fmt.Printf("Version %d, fetched %s\n", i+1, formatTime(meta.FetchedAt))
+
+		printDiff("Title", lines(prev.Title), lines(meta.Title))
+		printDiff("Channel", lines(prev.ChannelTitle), lines(meta.ChannelTitle))
+		printDiff("Tags", prev.Tags, meta.Tags)
+		printDiff("Description", lines(prev.Description), lines(meta.Description))
+
+		prev = meta
+	}
+
+	return nil
+}
+
+func printDiff(name string, a, b []string) {
+	if strings.Join(a, "\n") == strings.Join(b, "\n") {
+		return
+	}
+	fmt.Printf("--- %s\n", name)
+	for _, line := range utils.DiffLines(a, b) {
+		fmt.Println(line)
+	}
+}
+
+func lines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
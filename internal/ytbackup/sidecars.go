@@ -0,0 +1,96 @@
+package ytbackup
+
+import (
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+type SidecarsCommand struct {
+	Force bool `short:"f" long:"force" description:"Overwrite existing sidecar files"`
+	Command
+}
+
+func (cmd *SidecarsCommand) Execute([]string) error {
+	idx, err := cmd.LocalIndex()
+	if err != nil {
+		return err
+	}
+
+	ready := cmd.Storages.List()
+	roots := make(map[string]string)
+	for _, r := range ready {
+		roots[r.ID] = r.Path
+	}
+
+	videos := make([]*index.Video, 0)
+	err = idx.Iter(index.StatusDone, func(video *index.Video) error {
+		if cmd.Force || !hasNFO(video) {
+			videos = append(videos, video)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	written, skipped, failed := 0, 0, 0
+
+	for _, video := range videos {
+		if cmd.Ctx.Err() != nil {
+			break
+		}
+
+		online := true
+		for _, st := range video.Storages {
+			if _, ok := roots[st.ID]; !ok {
+				online = false
+			}
+		}
+		if !online || len(video.Storages) == 0 {
+			log.Warn().Str("id", video.ID).Msg("Some storages are offline, video skipped")
+			skipped++
+			continue
+		}
+
+		ok := true
+		for _, st := range video.Storages {
+			if err := cmd.Config.WriteSidecars(roots[st.ID], video); err != nil {
+				log.Err(err).Str("id", video.ID).Str("storage", st.ID).Msg("Could not write sidecar files")
+				ok = false
+				break
+			}
+		}
+		if !ok {
+			failed++
+			continue
+		}
+
+		if err := idx.Put(video); err != nil {
+			return err
+		}
+		written++
+	}
+
+	log.Info().Int("written", written).Int("skipped", skipped).Int("failed", failed).Msg("Sidecar files done")
+
+	if written > 0 {
+		if err := WriteManifests(idx, ready, nil); err != nil {
+			log.Err(err).Msg("Could not update manifests")
+		}
+	}
+
+	return nil
+}
+
+func hasNFO(video *index.Video) bool {
+	stem, ok := video.Stem()
+	if !ok {
+		return false
+	}
+	for _, f := range video.Files {
+		if f.Path == stem+".nfo" {
+			return true
+		}
+	}
+	return false
+}
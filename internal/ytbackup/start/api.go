@@ -2,61 +2,28 @@ package start
 
 import (
 	"context"
+	"time"
 
 	"github.com/rs/zerolog/log"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/youtube/v3"
+	"mkuznets.com/go/ytbackup/internal/index"
 	"mkuznets.com/go/ytbackup/internal/utils/ticker"
-	"mkuznets.com/go/ytbackup/internal/youtube"
+	yt "mkuznets.com/go/ytbackup/internal/youtube"
 )
 
 func (cmd *Command) RunAPICrawler(ctx context.Context) error {
-	videos := make([]string, 0, 50)
-
-	service, err := youtube.NewService(ctx, cmd.Config.Youtube.OAuth.Token())
-	if err != nil {
-		return err
-	}
-
 	return ticker.New(cmd.Config.Sources.UpdateInterval).Do(ctx, func() error {
 		log.Debug().Msg("Playlists: checking for new videos")
 
-	Playlists:
 		for title, playlistID := range cmd.Config.Sources.Playlists {
-			total := 0
-
-			call := service.PlaylistItems.List([]string{"contentDetails"})
-			call = call.PlaylistId(playlistID)
-			call = call.MaxResults(50)
-
-			for {
-				response, err := call.Do()
-				if err != nil {
-					if youtube.IsQuotaError(err) {
-						log.Error().Msg("Youtube API quota exceeded")
-						break Playlists
-					}
-					log.Err(err).Msg("Youtube API error")
+			total, err := cmd.crawlPlaylist(index.Source{Kind: index.SourcePlaylist, ID: playlistID}, playlistID, title, time.Time{})
+			if err != nil {
+				if yt.IsQuotaError(err) {
+					log.Warn().Err(err).Msg("Playlists: Youtube API quota")
 					break
 				}
-
-				videos = videos[:0]
-				for _, x := range response.Items {
-					videos = append(videos, x.ContentDetails.VideoId)
-				}
-
-				n, err := cmd.Index.Push(videos)
-				if err != nil {
-					log.Err(err).Msgf("Playlist `%s` error", title)
-				}
-
-				total += n
-				if n == 0 {
-					break
-				}
-
-				if response.NextPageToken == "" {
-					break
-				}
-				call.PageToken(response.NextPageToken)
+				log.Err(err).Msgf("Playlist `%s` error", title)
 			}
 
 			if total > 0 {
@@ -71,3 +38,140 @@ func (cmd *Command) RunAPICrawler(ctx context.Context) error {
 		return nil
 	})
 }
+
+// crawlPlaylist pushes new videos from the playlist to the index.
+//
+// An incremental scan stops at the first page without new videos.
+// A full scan walks all pages; it is performed when the playlist has never
+// been fully scanned, when it is requested by `ytbackup sync --full`, or
+// periodically. In both modes, if publishedAfter is set, pagination stops
+// at the first video published before that time.
+//
+// Videos are recorded with the given source. Videos of a source playlist are
+// also recorded as its members. A full scan updates their positions and marks
+// missing members as removed.
+func (cmd *Command) crawlPlaylist(src index.Source, playlistID, title string, publishedAfter time.Time) (int, error) {
+	members := src.Kind == index.SourcePlaylist
+
+	checkpoint, err := cmd.Index.Playlist(playlistID)
+	if err != nil {
+		return 0, err
+	}
+	if checkpoint == nil {
+		checkpoint = &index.Playlist{ID: playlistID}
+	}
+	checkpoint.Title = title
+
+	full := checkpoint.FullScanDue(cmd.Config.Sources.FullSyncInterval)
+	if full {
+		log.Info().Str("playlist", title).Msg("Full scan")
+	}
+
+	videos := make([]string, 0, 50)
+	total := 0
+	firstPage := true
+
+	parts := []string{"contentDetails"}
+	if members {
+		parts = append(parts, "snippet")
+	}
+	seen := make(map[string]struct{})
+
+	call := cmd.Youtube.PlaylistItems.List(parts)
+	call = call.PlaylistId(playlistID)
+	call = call.MaxResults(50)
+	if !full && checkpoint.ETag != "" {
+		call = call.IfNoneMatch(checkpoint.ETag)
+	}
+
+	for {
+		var response *youtube.PlaylistItemListResponse
+		err := cmd.Youtube.Do(yt.PriorityLow, yt.CostList, func() (err error) {
+			response, err = call.Do()
+			return err
+		})
+		if err != nil {
+			if googleapi.IsNotModified(err) {
+				log.Debug().Str("playlist", title).Msg("Playlist is not modified")
+				break
+			}
+			return total, err
+		}
+
+		if firstPage {
+			checkpoint.ETag = response.Etag
+			if response.PageInfo != nil {
+				checkpoint.ItemCount = response.PageInfo.TotalResults
+			}
+			call = call.IfNoneMatch("")
+			firstPage = false
+		}
+
+		videos = videos[:0]
+		items := make([]*index.Member, 0, len(response.Items))
+		tooOld := false
+
+		for _, x := range response.Items {
+			if !publishedAfter.IsZero() {
+				published, err := time.Parse(time.RFC3339, x.ContentDetails.VideoPublishedAt)
+				if err != nil {
+					// Private or deleted video
+					continue
+				}
+				if published.Before(publishedAfter) {
+					tooOld = true
+					continue
+				}
+			}
+			videos = append(videos, x.ContentDetails.VideoId)
+
+			if members && x.Snippet != nil {
+				items = append(items, &index.Member{
+					VideoID:  x.ContentDetails.VideoId,
+					Position: x.Snippet.Position,
+					AddedAt:  parseTime(x.Snippet.PublishedAt),
+				})
+				seen[x.ContentDetails.VideoId] = struct{}{}
+			}
+		}
+
+		n, err := cmd.Index.Push(src, videos)
+		if err != nil {
+			return total, err
+		}
+		if members {
+			if err := cmd.Index.PutMembers(playlistID, items, full); err != nil {
+				return total, err
+			}
+		}
+
+		total += n
+		if tooOld || (n == 0 && !full) {
+			break
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+		call.PageToken(response.NextPageToken)
+	}
+
+	checkpoint.LastScan = time.Now()
+
+	// Only a full scan sees all videos of the playlist
+	if full && members {
+		removed, err := cmd.Index.RemoveMembers(playlistID, seen, checkpoint.LastScan)
+		if err != nil {
+			return total, err
+		}
+		if removed > 0 {
+			log.Info().Str("playlist", title).Int("count", removed).Msg("Videos removed from playlist")
+		}
+	}
+
+	if err := cmd.Index.SaveScan(checkpoint, full); err != nil {
+		return total, err
+	}
+
+	return total, nil
+}
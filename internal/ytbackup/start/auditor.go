@@ -0,0 +1,63 @@
+package start
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/api/youtube/v3"
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+// auditDue reports whether the availability of the video has not been
+// checked within the audit interval.
+func (cmd *Command) auditDue(video *index.Video, now time.Time) bool {
+	interval := cmd.Config.Audit.Interval
+	return interval > 0 && (video.CheckedAt == nil || !video.CheckedAt.After(now.Add(-interval)))
+}
+
+// audit records whether downloaded videos are still available on Youtube
+// and when they disappear. Videos missing from the results are removed or private.
+func (cmd *Command) audit(ids []string, results []*youtube.Video, now time.Time) error {
+	reasons := make(map[string]string)
+	for _, id := range ids {
+		reasons[id] = "removed or private"
+	}
+	for _, result := range results {
+		if _, ok := reasons[result.Id]; !ok {
+			continue
+		}
+		if reason := unavailableReason(result.Status); reason != "" {
+			reasons[result.Id] = reason
+		} else {
+			delete(reasons, result.Id)
+		}
+	}
+
+	rescued, err := cmd.Index.RecordAvailability(ids, reasons, now)
+	if err != nil {
+		return err
+	}
+	if rescued > 0 {
+		log.Info().Int("videos", rescued).Msg("Auditor: backed up videos disappeared from Youtube")
+	}
+	return nil
+}
+
+// unavailableReason returns why a video returned by the API cannot be watched,
+// or an empty string if it is available.
+func unavailableReason(status *youtube.VideoStatus) string {
+	if status == nil {
+		return ""
+	}
+	switch {
+	case status.UploadStatus == "rejected":
+		return "rejected: " + status.RejectionReason
+	case status.UploadStatus == "deleted":
+		return "deleted"
+	case status.UploadStatus == "failed":
+		return "failed: " + status.FailureReason
+	case status.PrivacyStatus == "private":
+		return "private"
+	}
+	return ""
+}
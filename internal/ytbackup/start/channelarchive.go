@@ -0,0 +1,268 @@
+package start
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/api/youtube/v3"
+	"mkuznets.com/go/ytbackup/internal/channel"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/utils/ticker"
+	yt "mkuznets.com/go/ytbackup/internal/youtube"
+)
+
+const (
+	channelArchiveCheckInterval = time.Hour
+	channelArchiveBatchSize     = 50
+)
+
+// RunChannelArchiver periodically saves the identity and playlists of
+// channels of downloaded videos.
+func (cmd *Command) RunChannelArchiver(ctx context.Context) error {
+	endpoint := cmd.Youtube.Channels.List([]string{"snippet", "brandingSettings"})
+
+	return ticker.New(channelArchiveCheckInterval).Do(ctx, func() error {
+		due, err := cmd.dueChannels()
+		if err != nil {
+			log.Err(err).Msg("Index error")
+			return nil
+		}
+
+		for len(due) > 0 && ctx.Err() == nil {
+			n := channelArchiveBatchSize
+			if n > len(due) {
+				n = len(due)
+			}
+			batch := due[:n]
+			due = due[n:]
+
+			ids := make([]string, 0, len(batch))
+			for _, rec := range batch {
+				ids = append(ids, rec.ID)
+			}
+			endpoint.Id(strings.Join(ids, ","))
+
+			var r *youtube.ChannelListResponse
+			err = cmd.Youtube.Do(yt.PriorityLow, yt.CostList, func() (err error) {
+				r, err = endpoint.Do()
+				return err
+			})
+			if err != nil {
+				if yt.IsQuotaError(err) {
+					log.Debug().Err(err).Msg("Channel archiver: Youtube API quota")
+					return nil
+				}
+				log.Err(err).Msg("Youtube API error")
+				return nil
+			}
+
+			results := make(map[string]*youtube.Channel)
+			for _, result := range r.Items {
+				results[result.Id] = result
+			}
+
+			for _, rec := range batch {
+				if err := cmd.archiveChannel(rec, results[rec.ID]); err != nil {
+					if yt.IsQuotaError(err) {
+						log.Debug().Err(err).Msg("Channel archiver: Youtube API quota")
+						return nil
+					}
+					log.Err(err).Str("channel", rec.ID).Msg("Could not archive channel")
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// dueChannels returns records of channels of downloaded videos that
+// have not been archived within the interval.
+func (cmd *Command) dueChannels() ([]*index.Channel, error) {
+	channels, err := cmd.Index.Channels()
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]*index.Channel)
+	for _, ch := range channels {
+		known[ch.ID] = ch
+	}
+
+	threshold := time.Now().Add(-cmd.Config.ChannelArchive.Interval)
+	due := make([]*index.Channel, 0)
+	seen := make(map[string]struct{})
+
+	err = cmd.Index.Iter(index.StatusDone, func(video *index.Video) error {
+		if video.Meta == nil || video.Meta.ChannelID == "" {
+			return nil
+		}
+		id := video.Meta.ChannelID
+		if _, ok := seen[id]; ok {
+			return nil
+		}
+		seen[id] = struct{}{}
+
+		rec, ok := known[id]
+		if !ok {
+			rec = &index.Channel{ID: id, Title: video.Meta.ChannelTitle}
+		}
+		if rec.SyncedAt.Before(threshold) {
+			due = append(due, rec)
+		}
+		return nil
+	})
+
+	return due, err
+}
+
+// archiveChannel updates the channel directory on its storage.
+// Missing result means the channel is no longer available on Youtube.
+func (cmd *Command) archiveChannel(rec *index.Channel, result *youtube.Channel) error {
+	now := time.Now()
+
+	if result == nil {
+		if rec.MissingSince == nil {
+			log.Warn().Str("channel", rec.ID).Str("title", rec.Title).Msg("Channel is no longer available")
+			rec.MissingSince = &now
+		}
+		rec.SyncedAt = now
+		return cmd.Index.PutChannel(rec)
+	}
+
+	root := ""
+	for _, r := range cmd.Storages.List() {
+		if r.ID == rec.Storage {
+			root = r.Path
+		}
+	}
+	if root == "" {
+		if rec.Storage != "" {
+			log.Debug().Str("channel", rec.ID).Str("storage", rec.Storage).Msg("Storage is offline, channel skipped")
+			return nil
+		}
+		r, err := cmd.Storages.Select(0, rec.ID)
+		if err != nil {
+			return err
+		}
+		rec.Storage, root = r.ID, r.Path
+	}
+
+	archive, err := channel.Read(root, rec.ID)
+	if err != nil {
+		return err
+	}
+
+	if s := result.Snippet; s != nil {
+		archive.AddVersion(&channel.Version{
+			Title:       s.Title,
+			Description: s.Description,
+			CustomURL:   s.CustomUrl,
+			Country:     s.Country,
+			FetchedAt:   now,
+		})
+		rec.Title = s.Title
+
+		if t := s.Thumbnails; t != nil && t.High != nil {
+			if _, err := archive.SaveImage(root, channel.Avatar, t.High.Url, now); err != nil {
+				log.Err(err).Str("channel", rec.ID).Msg("Could not save avatar")
+			}
+		}
+	}
+	if b := result.BrandingSettings; b != nil && b.Image != nil && b.Image.BannerExternalUrl != "" {
+		if _, err := archive.SaveImage(root, channel.Banner, b.Image.BannerExternalUrl, now); err != nil {
+			log.Err(err).Str("channel", rec.ID).Msg("Could not save banner")
+		}
+	}
+
+	playlists, err := cmd.channelPlaylists(rec.ID)
+	if err != nil {
+		return err
+	}
+	archive.SetPlaylists(playlists, now)
+	archive.SyncedAt = now
+
+	file, err := archive.Write(root)
+	if err != nil {
+		return err
+	}
+
+	rec.Files = archive.Files(file)
+	rec.SyncedAt = now
+	rec.MissingSince = nil
+
+	return cmd.Index.PutChannel(rec)
+}
+
+// channelPlaylists returns public playlists of a channel with their videos in order.
+func (cmd *Command) channelPlaylists(channelID string) ([]*channel.Playlist, error) {
+	call := cmd.Youtube.Playlists.List([]string{"snippet"})
+	call = call.ChannelId(channelID)
+	call = call.MaxResults(50)
+
+	playlists := make([]*channel.Playlist, 0)
+
+	for {
+		var response *youtube.PlaylistListResponse
+		err := cmd.Youtube.Do(yt.PriorityLow, yt.CostList, func() (err error) {
+			response, err = call.Do()
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range response.Items {
+			p := &channel.Playlist{ID: item.Id}
+			if s := item.Snippet; s != nil {
+				p.Title = s.Title
+				p.Description = s.Description
+				p.PublishedAt = parseTime(s.PublishedAt)
+			}
+
+			p.Videos, err = cmd.playlistVideos(item.Id)
+			if err != nil {
+				return nil, err
+			}
+			playlists = append(playlists, p)
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+		call.PageToken(response.NextPageToken)
+	}
+
+	return playlists, nil
+}
+
+// playlistVideos returns IDs of videos of a playlist in their order.
+func (cmd *Command) playlistVideos(playlistID string) ([]string, error) {
+	call := cmd.Youtube.PlaylistItems.List([]string{"contentDetails"})
+	call = call.PlaylistId(playlistID)
+	call = call.MaxResults(50)
+
+	videos := make([]string, 0)
+
+	for {
+		var response *youtube.PlaylistItemListResponse
+		err := cmd.Youtube.Do(yt.PriorityLow, yt.CostList, func() (err error) {
+			response, err = call.Do()
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range response.Items {
+			videos = append(videos, item.ContentDetails.VideoId)
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+		call.PageToken(response.NextPageToken)
+	}
+
+	return videos, nil
+}
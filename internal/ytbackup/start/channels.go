@@ -0,0 +1,170 @@
+package start
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/api/youtube/v3"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/utils/ticker"
+	yt "mkuznets.com/go/ytbackup/internal/youtube"
+)
+
+type channelSource struct {
+	ID, Title      string
+	PublishedAfter time.Time
+}
+
+func (cmd *Command) RunChannelCrawler(ctx context.Context) error {
+	// Uploads playlists do not change, resolve them once per channel.
+	uploads := make(map[string]string)
+
+	return ticker.New(cmd.Config.Sources.UpdateInterval).Do(ctx, func() error {
+		log.Debug().Msg("Channels: checking for new videos")
+
+		channels, err := cmd.channelSources()
+		if err != nil {
+			if yt.IsQuotaError(err) {
+				log.Warn().Err(err).Msg("Channels: Youtube API quota")
+				return nil
+			}
+			log.Err(err).Msg("Channels error")
+			return nil
+		}
+
+		if err := cmd.resolveUploads(channels, uploads); err != nil {
+			if yt.IsQuotaError(err) {
+				log.Warn().Err(err).Msg("Channels: Youtube API quota")
+				return nil
+			}
+			log.Err(err).Msg("Channels error")
+		}
+
+		for _, ch := range channels {
+			playlistID, ok := uploads[ch.ID]
+			if !ok {
+				log.Warn().Str("channel", ch.ID).Msg("Uploads playlist not found")
+				continue
+			}
+
+			total, err := cmd.crawlPlaylist(index.Source{Kind: index.SourceChannel, ID: ch.ID}, playlistID, ch.Title, ch.PublishedAfter)
+			if err != nil {
+				if yt.IsQuotaError(err) {
+					log.Warn().Err(err).Msg("Channels: Youtube API quota")
+					break
+				}
+				log.Err(err).Msgf("Channel `%s` error", ch.Title)
+			}
+
+			if total > 0 {
+				log.Info().
+					Str("channel", ch.Title).
+					Int("count", total).
+					Msg("New videos from channel")
+			}
+		}
+
+		log.Debug().Msg("Channels: done")
+		return nil
+	})
+}
+
+// channelSources returns explicitly configured channels and, if enabled,
+// the channels the user is subscribed to.
+func (cmd *Command) channelSources() ([]*channelSource, error) {
+	cfg := &cmd.Config.Sources.Channels
+
+	channels := make([]*channelSource, 0, len(cfg.List))
+	seen := make(map[string]struct{})
+
+	for title, ch := range cfg.List {
+		after := ch.PublishedAfter
+		if after.IsZero() {
+			after = cfg.PublishedAfter
+		}
+		channels = append(channels, &channelSource{ID: ch.ID, Title: title, PublishedAfter: after})
+		seen[ch.ID] = struct{}{}
+	}
+
+	if !cfg.Subscriptions {
+		return channels, nil
+	}
+
+	call := cmd.Youtube.Subscriptions.List([]string{"snippet"})
+	call = call.Mine(true)
+	call = call.MaxResults(50)
+
+	for {
+		var response *youtube.SubscriptionListResponse
+		err := cmd.Youtube.Do(yt.PriorityLow, yt.CostList, func() (err error) {
+			response, err = call.Do()
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("could not get subscriptions: %w", err)
+		}
+
+		for _, x := range response.Items {
+			id := x.Snippet.ResourceId.ChannelId
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			channels = append(channels, &channelSource{
+				ID:             id,
+				Title:          x.Snippet.Title,
+				PublishedAfter: cfg.PublishedAfter,
+			})
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+		call.PageToken(response.NextPageToken)
+	}
+
+	return channels, nil
+}
+
+// resolveUploads finds uploads playlists of channels missing from the cache.
+func (cmd *Command) resolveUploads(channels []*channelSource, uploads map[string]string) error {
+	ids := make([]string, 0, 50)
+	for _, ch := range channels {
+		if _, ok := uploads[ch.ID]; !ok {
+			ids = append(ids, ch.ID)
+		}
+	}
+
+	for len(ids) > 0 {
+		n := len(ids)
+		if n > 50 {
+			n = 50
+		}
+
+		call := cmd.Youtube.Channels.List([]string{"contentDetails"})
+		call = call.Id(strings.Join(ids[:n], ","))
+		call = call.MaxResults(50)
+
+		var response *youtube.ChannelListResponse
+		err := cmd.Youtube.Do(yt.PriorityLow, yt.CostList, func() (err error) {
+			response, err = call.Do()
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("could not get channels: %w", err)
+		}
+
+		for _, x := range response.Items {
+			if x.ContentDetails != nil && x.ContentDetails.RelatedPlaylists != nil {
+				uploads[x.Id] = x.ContentDetails.RelatedPlaylists.Uploads
+			}
+		}
+
+		ids = ids[n:]
+	}
+
+	return nil
+}
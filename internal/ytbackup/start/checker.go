@@ -0,0 +1,112 @@
+package start
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/api/youtube/v3"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/utils/ticker"
+	yt "mkuznets.com/go/ytbackup/internal/youtube"
+)
+
+const (
+	checkInterval  = 10 * time.Minute
+	checkBatchSize = 50
+)
+
+// RunChecker periodically fetches downloaded videos from Youtube to audit their
+// availability and refresh their metadata. Each pass reads the index once,
+// resuming from a cursor, and videos due for both checks cost a single request.
+func (cmd *Command) RunChecker(ctx context.Context) error {
+	return ticker.New(checkInterval).Do(ctx, func() error {
+		now := time.Now()
+		cursor := ""
+		batch := make([]*index.Video, 0, checkBatchSize)
+
+		for ctx.Err() == nil {
+			videos, next, err := cmd.Index.Page(index.StatusDone, cursor, checkBatchSize)
+			if err != nil {
+				log.Err(err).Msg("Index error")
+				return nil
+			}
+
+			for _, video := range videos {
+				if !cmd.auditDue(video, now) && !cmd.refreshDue(video, now) {
+					continue
+				}
+				batch = append(batch, video)
+				if len(batch) < checkBatchSize {
+					continue
+				}
+				if !cmd.checkBatch(batch, now) {
+					return nil
+				}
+				batch = batch[:0]
+			}
+
+			if next == "" {
+				break
+			}
+			cursor = next
+		}
+
+		if len(batch) > 0 && ctx.Err() == nil {
+			cmd.checkBatch(batch, now)
+		}
+		return nil
+	})
+}
+
+// checkBatch requests the videos from the API and hands the results to the
+// auditor and the refresher. It returns false if the pass has to stop.
+func (cmd *Command) checkBatch(videos []*index.Video, now time.Time) bool {
+	ids := make([]string, 0, len(videos))
+	audit := make([]string, 0, len(videos))
+	refresh := make([]string, 0, len(videos))
+	for _, video := range videos {
+		ids = append(ids, video.ID)
+		if cmd.auditDue(video, now) {
+			audit = append(audit, video.ID)
+		}
+		if cmd.refreshDue(video, now) {
+			refresh = append(refresh, video.ID)
+		}
+	}
+
+	parts := []string{"status"}
+	if len(refresh) > 0 {
+		parts = metaParts()
+	}
+	call := cmd.Youtube.Videos.List(parts).Id(strings.Join(ids, ","))
+
+	var r *youtube.VideoListResponse
+	err := cmd.Youtube.Do(yt.PriorityLow, yt.CostList, func() (err error) {
+		r, err = call.Do()
+		return err
+	})
+	if err != nil {
+		if yt.IsQuotaError(err) {
+			log.Debug().Err(err).Msg("Checker: Youtube API quota")
+			return false
+		}
+		log.Err(err).Msg("Youtube API error")
+		return false
+	}
+
+	if len(audit) > 0 {
+		if err := cmd.audit(audit, r.Items, now); err != nil {
+			log.Err(err).Msg("Index error")
+			return false
+		}
+	}
+	if len(refresh) > 0 {
+		if err := cmd.refresh(refresh, r.Items, now); err != nil {
+			log.Err(err).Msg("Index error")
+			return false
+		}
+	}
+	return true
+}
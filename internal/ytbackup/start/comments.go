@@ -0,0 +1,306 @@
+package start
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/youtube/v3"
+	"mkuznets.com/go/ytbackup/internal/comments"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/utils/ticker"
+	yt "mkuznets.com/go/ytbackup/internal/youtube"
+)
+
+const (
+	commentsCheckInterval = 10 * time.Minute
+	commentsBatchSize     = 10
+	commentsPageSize      = 100
+)
+
+// RunCommentsArchiver periodically saves new comments of downloaded videos
+// selected by the config.
+func (cmd *Command) RunCommentsArchiver(ctx context.Context) error {
+	return ticker.New(commentsCheckInterval).Do(ctx, func() error {
+		selected, err := cmd.commentsFilter()
+		if err != nil {
+			log.Err(err).Msg("Index error")
+			return nil
+		}
+
+		roots := make(map[string]string)
+		for _, r := range cmd.Storages.List() {
+			roots[r.ID] = r.Path
+		}
+
+		for ctx.Err() == nil {
+			videos, err := cmd.dueForComments(selected, roots, commentsBatchSize)
+			if err != nil {
+				log.Err(err).Msg("Index error")
+				return nil
+			}
+			if len(videos) == 0 {
+				return nil
+			}
+
+			for _, video := range videos {
+				if err := cmd.archiveComments(video, roots); err != nil {
+					if yt.IsQuotaError(err) {
+						log.Debug().Err(err).Msg("Comments: Youtube API quota")
+						return nil
+					}
+					log.Err(err).Str("id", video.ID).Msg("Could not archive comments")
+
+					// Postpone the video until the next interval so that it does not block the others
+					if err := cmd.Index.PutComments(video.ID, nil, time.Now()); err != nil {
+						log.Err(err).Msg("Index error")
+						return nil
+					}
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// commentsFilter returns a function reporting whether comments of a video
+// have to be archived according to `comments.playlists` and `comments.channels`.
+func (cmd *Command) commentsFilter() (func(*index.Video) bool, error) {
+	cfg := &cmd.Config.Comments
+	if len(cfg.Playlists) == 0 && len(cfg.Channels) == 0 {
+		return func(*index.Video) bool { return true }, nil
+	}
+
+	ids := make(map[string]struct{})
+	for _, title := range cfg.Playlists {
+		members, err := cmd.Index.Members(cmd.Config.Sources.Playlists[title])
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range members {
+			ids[m.VideoID] = struct{}{}
+		}
+	}
+
+	channels := make(map[string]struct{})
+	for _, id := range cfg.Channels {
+		channels[id] = struct{}{}
+	}
+
+	return func(video *index.Video) bool {
+		if _, ok := ids[video.ID]; ok {
+			return true
+		}
+		if video.Meta == nil {
+			return false
+		}
+		_, ok := channels[video.Meta.ChannelID]
+		return ok
+	}, nil
+}
+
+// dueForComments returns downloaded videos whose comments have not been
+// archived within the interval. Videos with offline storages are skipped.
+func (cmd *Command) dueForComments(selected func(*index.Video) bool, roots map[string]string, n int) ([]*index.Video, error) {
+	videos := make([]*index.Video, 0, n)
+	threshold := time.Now().Add(-cmd.Config.Comments.Interval)
+
+	err := cmd.Index.Iter(index.StatusDone, func(video *index.Video) error {
+		if video.CommentsAt != nil && video.CommentsAt.After(threshold) {
+			return nil
+		}
+		if video.Unavailable != nil || len(video.Storages) == 0 || !selected(video) {
+			return nil
+		}
+		if _, ok := video.Stem(); !ok {
+			return nil
+		}
+		for _, st := range video.Storages {
+			if _, ok := roots[st.ID]; !ok {
+				return nil
+			}
+		}
+
+		videos = append(videos, video)
+		if len(videos) >= n {
+			return index.ErrStop
+		}
+		return nil
+	})
+
+	return videos, err
+}
+
+// archiveComments merges new comments into the comments file of the video on all its storages.
+func (cmd *Command) archiveComments(video *index.Video, roots map[string]string) error {
+	stem, _ := video.Stem()
+	path := stem + comments.Suffix
+
+	archive, err := comments.Read(filepath.Join(roots[video.Storages[0].ID], path), video.ID)
+	if err != nil {
+		return err
+	}
+
+	threads, err := cmd.fetchComments(video.ID, archive)
+	added := 0
+	switch {
+	case isCommentsDisabled(err):
+		archive.Disabled = true
+	case err != nil:
+		return err
+	default:
+		archive.Disabled = false
+		added = archive.Merge(threads)
+	}
+
+	now := time.Now()
+	archive.FetchedAt = now
+
+	var file *index.File
+	for _, st := range video.Storages {
+		if file, err = archive.Write(roots[st.ID], path); err != nil {
+			return err
+		}
+	}
+
+	if err := cmd.Index.PutComments(video.ID, file, now); err != nil {
+		return err
+	}
+
+	if added > 0 {
+		log.Info().Str("id", video.ID).Int("count", added).Msg("New comments archived")
+	}
+	return nil
+}
+
+// fetchComments returns comment threads of a video newest first. Pages are
+// requested until a thread older than the newest archived one is reached,
+// so new replies to older threads are not picked up by incremental refreshes.
+func (cmd *Command) fetchComments(id string, archive *comments.Archive) ([]*comments.Comment, error) {
+	var latest time.Time
+	for _, t := range archive.Threads {
+		if t.PublishedAt.After(latest) {
+			latest = t.PublishedAt
+		}
+	}
+
+	call := cmd.Youtube.CommentThreads.List([]string{"snippet", "replies"})
+	call = call.VideoId(id)
+	call = call.Order("time")
+	call = call.TextFormat("plainText")
+	call = call.MaxResults(commentsPageSize)
+
+	threads := make([]*comments.Comment, 0)
+
+	for {
+		var response *youtube.CommentThreadListResponse
+		err := cmd.Youtube.Do(yt.PriorityLow, yt.CostList, func() (err error) {
+			response, err = call.Do()
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		tooOld := false
+		for _, item := range response.Items {
+			if item.Snippet == nil || item.Snippet.TopLevelComment == nil {
+				continue
+			}
+
+			thread := fromAPIComment(item.Snippet.TopLevelComment)
+			thread.ReplyCount = item.Snippet.TotalReplyCount
+			if item.Replies != nil {
+				for _, r := range item.Replies.Comments {
+					thread.Replies = append(thread.Replies, fromAPIComment(r))
+				}
+			}
+
+			if !thread.PublishedAt.After(latest) {
+				tooOld = true
+			}
+
+			// Only a few replies are included in the thread
+			known := archive.Thread(thread.ID)
+			if int64(len(thread.Replies)) < thread.ReplyCount && (known == nil || int64(len(known.Replies)) < thread.ReplyCount) {
+				replies, err := cmd.fetchReplies(thread.ID)
+				if err != nil {
+					return nil, err
+				}
+				thread.Replies = replies
+			}
+
+			threads = append(threads, thread)
+		}
+
+		if tooOld || response.NextPageToken == "" {
+			break
+		}
+		call.PageToken(response.NextPageToken)
+	}
+
+	return threads, nil
+}
+
+func (cmd *Command) fetchReplies(threadID string) ([]*comments.Comment, error) {
+	call := cmd.Youtube.Comments.List([]string{"snippet"})
+	call = call.ParentId(threadID)
+	call = call.TextFormat("plainText")
+	call = call.MaxResults(commentsPageSize)
+
+	replies := make([]*comments.Comment, 0)
+
+	for {
+		var response *youtube.CommentListResponse
+		err := cmd.Youtube.Do(yt.PriorityLow, yt.CostList, func() (err error) {
+			response, err = call.Do()
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range response.Items {
+			replies = append(replies, fromAPIComment(c))
+		}
+
+		if response.NextPageToken == "" {
+			break
+		}
+		call.PageToken(response.NextPageToken)
+	}
+
+	return replies, nil
+}
+
+func fromAPIComment(c *youtube.Comment) *comments.Comment {
+	comment := &comments.Comment{ID: c.Id}
+
+	if s := c.Snippet; s != nil {
+		comment.Author = s.AuthorDisplayName
+		if s.AuthorChannelId != nil {
+			comment.AuthorChannelID = s.AuthorChannelId.Value
+		}
+		comment.Text = s.TextDisplay
+		comment.Likes = s.LikeCount
+		comment.PublishedAt = parseTime(s.PublishedAt)
+		comment.UpdatedAt = parseTime(s.UpdatedAt)
+	}
+
+	return comment
+}
+
+func isCommentsDisabled(err error) bool {
+	var gErr *googleapi.Error
+	if errors.As(err, &gErr) {
+		for _, e := range gErr.Errors {
+			if e.Reason == "commentsDisabled" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -2,75 +2,113 @@ package start
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"path/filepath"
-	"strings"
+	"sync"
 	"time"
 
-	"github.com/rs/zerolog/log"
 	"mkuznets.com/go/ytbackup/internal/index"
 	"mkuznets.com/go/ytbackup/internal/python"
+	"mkuznets.com/go/ytbackup/internal/storages"
 	"mkuznets.com/go/ytbackup/internal/utils"
 	"mkuznets.com/go/ytbackup/internal/utils/ticker"
+	"mkuznets.com/go/ytbackup/internal/ytbackup"
 )
 
 const (
 	systemErrorDowntime = 2 * time.Minute
+	estimateTimeout     = 2 * time.Minute
 	ytVideoURLFormat    = "https://www.youtube.com/watch?v=%s"
 )
 
-type videoIDKey struct{}
-
 type Result struct {
 	ID    string
 	Files []index.File
 }
 
 func (cmd *Command) RunDownloader(ctx context.Context) error {
-	return ticker.New(5*time.Second).Do(ctx, func() error {
-		videos, err := cmd.Index.Pop(1)
-		if err != nil {
-			log.Err(err).Msg("index: Pop error")
-			return nil
-		}
+	wg := &sync.WaitGroup{}
+
+	for _, w := range cmd.workers {
+		w := w
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.logger.Debug().Msg("Worker started")
+
+			ticker.New(5*time.Second).MustDo(ctx, func() error {
+				videos, err := cmd.Index.Pop(1)
+				if err != nil {
+					w.logger.Err(err).Msg("index: Pop error")
+					return nil
+				}
+
+				if len(videos) > 0 {
+					cmd.download(ctx, w, videos)
+				}
+				return nil
+			})
+
+			w.logger.Debug().Msg("Worker stopped")
+		}()
+	}
 
-		if len(videos) > 0 {
-			cmd.download(ctx, videos)
-		}
-		return nil
-	})
+	wg.Wait()
+	return nil
 }
 
-func (cmd *Command) download(ctx context.Context, videos []*index.Video) {
-	storage, err := cmd.Storages.Get()
-	if err != nil {
-		log.Err(err).Msgf("no suitable storage, sleeping for %s", systemErrorDowntime)
-		utils.SleepContext(ctx, systemErrorDowntime)
-		return
-	}
-
+func (cmd *Command) download(ctx context.Context, w *worker, videos []*index.Video) {
 	for _, video := range videos {
-		log.Info().Str("id", video.ID).Msg("Downloading")
+		w.start(video.ID)
 
-		results, err := cmd.downloadByID(video, storage.Path)
+		profile, opts := cmd.Config.YDLOptions(video)
+		optsArg, err := json.Marshal(opts)
 		if err != nil {
-			log.Err(err).Str("id", video.ID).Msg("Download error")
+			w.finish()
+			cmd.handleFailure(ctx, w, video, fmt.Errorf("could not encode youtube-dl options: %v", err), python.ReasonUnknown)
+			continue
+		}
 
-			if isSystemError(err) {
-				log.Warn().Msgf("System error, sleeping for %s", systemErrorDowntime)
-				_ = cmd.Index.Retry(video.ID, index.RetryInfinite)
-				utils.SleepContext(ctx, systemErrorDowntime)
-				continue
-			}
+		size, err := cmd.estimateSize(video, string(optsArg))
+		if err != nil {
+			w.finish()
+			cmd.handleFailure(ctx, w, video, err, python.ReasonOf(err))
+			continue
+		}
 
-			if isRetriable(err) {
-				_ = cmd.Index.Retry(video.ID, index.RetryLimited)
-			} else {
-				log.Info().Str("id", video.ID).Msg("Download failed with non-retriable error")
-				video.Status = index.StatusFailed
-				video.Reason = err.Error()
-				_ = cmd.Index.Put(video)
+		var channelID string
+		if video.Meta != nil {
+			channelID = video.Meta.ChannelID
+		}
+
+		storage, err := cmd.Storages.Select(size, channelID)
+		if err != nil {
+			w.finish()
+			reason := python.ReasonDiskFull
+			var serr *storages.SelectError
+			if errors.As(err, &serr) && serr.Permanent {
+				reason = python.ReasonNoStorage
 			}
+			cmd.handleFailure(ctx, w, video, err, reason)
+			continue
+		}
+
+		w.logger.Info().
+			Str("id", video.ID).
+			Str("storage", storage.ID).
+			Str("profile", profile).
+			Str("size", utils.IBytes(size)).
+			Msg("Downloading")
+
+		results, err := cmd.downloadByID(w, video, storage.Path, string(optsArg))
+		cmd.Storages.Release(storage)
+		w.finish()
+
+		if err != nil {
+			cmd.handleFailure(ctx, w, video, err, python.ReasonOf(err))
 			continue
 		}
 
@@ -81,33 +119,85 @@ func (cmd *Command) download(ctx context.Context, videos []*index.Video) {
 
 			video.Storages = []index.Storage{{ID: storage.ID}}
 			video.Files = res.Files
+			video.Profile = profile
 			video.Status = index.StatusDone
 
+			if cmd.Config.Sidecars.Enable {
+				if err := cmd.Config.WriteSidecars(storage.Path, video); err != nil {
+					w.logger.Err(err).Str("id", video.ID).Msg("Could not write sidecar files")
+				}
+			}
+
 			if err := cmd.Index.Put(video); err != nil {
-				log.Err(err).Str("id", video.ID).Msg("Index error")
+				w.logger.Err(err).Str("id", video.ID).Msg("Index error")
 				continue
 			}
 
-			log.Info().
+			w.logger.Info().
 				Str("id", res.ID).
 				Msg("Download complete")
 		}
 	}
 }
 
-func (cmd *Command) downloadByID(video *index.Video, rootDir string) ([]*Result, error) {
-	ctx, cancel := context.WithCancel(cmd.CriticalCtx)
+func (cmd *Command) handleFailure(ctx context.Context, w *worker, video *index.Video, err error, reason python.Reason) {
+	action := cmd.Config.ErrorAction(reason)
+
+	w.logger.Err(err).
+		Str("id", video.ID).
+		Str("reason", string(reason)).
+		Str("action", string(action)).
+		Msg("Download error")
+
+	attempt := index.Attempt{Time: time.Now(), Error: err.Error(), Reason: string(reason)}
+
+	switch action {
+	case ytbackup.ActionPause:
+		w.logger.Warn().Msgf("Pausing for %s", systemErrorDowntime)
+		_ = cmd.Index.Retry(video.ID, cmd.Config.RetryPolicy(reason), attempt)
+		utils.SleepContext(ctx, systemErrorDowntime)
+	case ytbackup.ActionFail:
+		video.AddAttempt(attempt)
+		video.Status = index.StatusFailed
+		video.Reason = err.Error()
+		_ = cmd.Index.Put(video)
+	default:
+		_ = cmd.Index.Retry(video.ID, cmd.Config.RetryPolicy(reason), attempt)
+	}
+}
+
+// estimateSize returns the expected size of the video files based on
+// the format info, or 0 if the size is unknown.
+func (cmd *Command) estimateSize(video *index.Video, opts string) (uint64, error) {
+	ctx, cancel := context.WithTimeout(cmd.CriticalCtx, estimateTimeout)
 	defer cancel()
 
-	ctx = context.WithValue(ctx, videoIDKey{}, video.ID)
+	var result struct {
+		ID   string
+		Size uint64
+	}
 
-	published := video.Meta.PublishedAt
-	destDir := filepath.Join(
-		rootDir,
-		published.Format("2006"),
-		published.Format("01"),
-		fmt.Sprintf("%s_%s", published.Format("20060102"), video.ID),
+	err := cmd.Python.RunScript(
+		ctx,
+		&result,
+		"dl.py",
+		"--estimate",
+		"--cache="+cmd.Config.Dirs.Cache,
+		"--options="+opts,
+		fmt.Sprintf(ytVideoURLFormat, video.ID),
 	)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Size, nil
+}
+
+func (cmd *Command) downloadByID(w *worker, video *index.Video, rootDir, opts string) ([]*Result, error) {
+	ctx, cancel := context.WithCancel(cmd.CriticalCtx)
+	defer cancel()
+
+	path := cmd.Config.Downloader.Layout.Path(video.ID, video.Meta)
 
 	logPath := filepath.Join(
 		cmd.Config.Dirs.Logs(),
@@ -119,7 +209,9 @@ func (cmd *Command) downloadByID(video *index.Video, rootDir string) ([]*Result,
 		"--log=" + logPath,
 		"--root=" + rootDir,
 		"--cache=" + cmd.Config.Dirs.Cache,
-		"--dst=" + destDir,
+		"--dst=" + filepath.Join(rootDir, filepath.Dir(path)),
+		"--name=" + filepath.Base(path),
+		"--options=" + opts,
 		fmt.Sprintf(ytVideoURLFormat, video.ID),
 	}
 
@@ -129,7 +221,7 @@ func (cmd *Command) downloadByID(video *index.Video, rootDir string) ([]*Result,
 			return nil
 		})
 	}()
-	go trackProgress(ctx, cancel, logPath)
+	go trackProgress(ctx, cancel, w, logPath)
 
 	var result []*Result
 	if err := cmd.Python.RunScript(ctx, &result, cargs...); err != nil {
@@ -138,24 +230,3 @@ func (cmd *Command) downloadByID(video *index.Video, rootDir string) ([]*Result,
 
 	return result, nil
 }
-
-func isSystemError(err error) bool {
-	e, ok := err.(*python.ScriptError)
-	return ok && e.Reason == "system"
-}
-
-func isRetriable(err error) bool {
-	text := err.Error()
-	if strings.Contains(text, "video is private") ||
-		strings.Contains(text, "no longer available") ||
-		strings.Contains(text, "not available") ||
-		strings.Contains(text, "video has been removed") ||
-		strings.Contains(text, "copyright grounds") ||
-		strings.Contains(text, "in your country") ||
-		strings.Contains(text, "confirm your age") ||
-		strings.Contains(text, "recording is not available") ||
-		strings.Contains(text, "video is unavailable") {
-		return false
-	}
-	return true
-}
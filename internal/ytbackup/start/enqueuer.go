@@ -2,6 +2,7 @@ package start
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -14,13 +15,17 @@ import (
 	yt "mkuznets.com/go/ytbackup/internal/youtube"
 )
 
-func (cmd *Command) RunEnqueuer(ctx context.Context) error {
-	service, err := yt.NewService(cmd.Ctx, cmd.Config.Youtube.OAuth.Token())
-	if err != nil {
-		return err
-	}
+const (
+	// liveRecheckInterval is a period of checks of ongoing or overdue live streams.
+	liveRecheckInterval = 30 * time.Minute
+	// vodProcessingDelay is the time given to Youtube to process a VOD after a stream has ended.
+	vodProcessingDelay = time.Hour
+	// abandonedAfter is the time after which an upcoming stream that has not started is skipped.
+	abandonedAfter = 7 * 24 * time.Hour
+)
 
-	endpoint := service.Videos.List([]string{"snippet", "contentDetails"})
+func (cmd *Command) RunEnqueuer(ctx context.Context) error {
+	endpoint := cmd.Youtube.Videos.List(metaParts("liveStreamingDetails"))
 
 	return ticker.New(5*time.Second).Do(ctx, func() error {
 		videos, err := cmd.Index.Get(index.StatusNew, 50)
@@ -40,8 +45,18 @@ func (cmd *Command) RunEnqueuer(ctx context.Context) error {
 
 		endpoint.Id(strings.Join(ids, ","))
 
-		r, err := endpoint.Do()
+		var r *youtube.VideoListResponse
+		err = cmd.Youtube.Do(yt.PriorityHigh, yt.CostList, func() (err error) {
+			r, err = endpoint.Do()
+			return err
+		})
 		if err != nil {
+			var qErr *yt.QuotaError
+			if errors.As(err, &qErr) {
+				log.Warn().Err(err).Msg("Enqueuer: Youtube API quota")
+				utils.SleepContext(ctx, time.Until(qErr.ResetAt))
+				return nil
+			}
 			log.Err(err).Msg("Youtube API error")
 			time.Sleep(systemErrorDowntime)
 			return nil
@@ -75,42 +90,139 @@ func (cmd *Command) RunEnqueuer(ctx context.Context) error {
 }
 
 func (cmd *Command) fromAPIResult(video *index.Video, result *youtube.Video) {
-	publishedAt, err := time.Parse(time.RFC3339, result.Snippet.PublishedAt)
+	meta, err := metaFromAPI(result)
 	if err != nil {
 		video.Status = index.StatusFailed
-		video.Reason = fmt.Sprintf("could not parse upload time: %v", err)
+		video.Reason = err.Error()
 		return
 	}
 
-	dur, err := utils.ParseISO8601(result.ContentDetails.Duration)
-	if err != nil {
-		video.Status = index.StatusFailed
-		video.Reason = fmt.Sprintf("could not parse duration: %v", err)
+	video.Meta = meta
+	video.Status = index.StatusEnqueued
+	video.Reason = ""
+	video.ScheduledAt = nil
+
+	if schedule(video, result, time.Now()) {
 		return
 	}
 
-	video.Meta = &index.Meta{
-		Title:        result.Snippet.Title,
-		Description:  result.Snippet.Description,
-		ChannelID:    result.Snippet.ChannelId,
-		ChannelTitle: result.Snippet.ChannelTitle,
-		Tags:         result.Snippet.Tags,
+	if time.Duration(meta.Duration)*time.Second > cmd.Config.MaxDuration(video) {
+		video.Status = index.StatusSkipped
+		video.Reason = "too long"
+	}
+}
+
+// metaParts returns the parts of Videos.List required by metaFromAPI and extra ones.
+func metaParts(extra ...string) []string {
+	return append([]string{"snippet", "contentDetails", "statistics", "status"}, extra...)
+}
+
+// metaFromAPI returns metadata of a video from the API result with the parts of metaParts.
+func metaFromAPI(result *youtube.Video) (*index.Meta, error) {
+	snippet := result.Snippet
+
+	publishedAt, err := time.Parse(time.RFC3339, snippet.PublishedAt)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse upload time: %v", err)
+	}
+
+	meta := &index.Meta{
+		Title:        snippet.Title,
+		Description:  snippet.Description,
+		ChannelID:    snippet.ChannelId,
+		ChannelTitle: snippet.ChannelTitle,
+		Tags:         snippet.Tags,
 		PublishedAt:  publishedAt,
+		Category:     yt.CategoryName(snippet.CategoryId),
+		Language:     snippet.DefaultAudioLanguage,
+		FetchedAt:    time.Now(),
+	}
+	if meta.Language == "" {
+		meta.Language = snippet.DefaultLanguage
 	}
-	video.Status = index.StatusEnqueued
 
-	// TODO: postpone `upcoming` videos until scheduledStartTime
-	// (requires additional request with liveStreamingDetails part)
-	liveStatus := result.Snippet.LiveBroadcastContent
-	if liveStatus == "live" || liveStatus == "upcoming" {
-		video.Status = index.StatusSkipped
-		video.Reason = liveStatus
+	if t := snippet.Thumbnails; t != nil {
+		for _, thumb := range []*youtube.Thumbnail{t.Default, t.Medium, t.High, t.Standard, t.Maxres} {
+			if thumb != nil {
+				meta.Thumbnails = append(meta.Thumbnails, index.Thumbnail{
+					URL:    thumb.Url,
+					Width:  int(thumb.Width),
+					Height: int(thumb.Height),
+				})
+			}
+		}
 	}
 
-	if dur > cmd.Config.Sources.MaxDuration {
-		video.Status = index.StatusSkipped
-		video.Reason = "too long"
+	if details := result.ContentDetails; details != nil {
+		dur, err := utils.ParseISO8601(details.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse duration: %v", err)
+		}
+		meta.Duration = int(dur / time.Second)
+		meta.Captions = details.Caption == "true"
+	}
+
+	if stats := result.Statistics; stats != nil {
+		meta.Statistics = &index.Statistics{
+			Views:    stats.ViewCount,
+			Likes:    stats.LikeCount,
+			Comments: stats.CommentCount,
+		}
+	}
+
+	if status := result.Status; status != nil {
+		meta.License = status.License
+	}
+
+	return meta, nil
+}
+
+// schedule postpones upcoming and live videos, as well as streams
+// whose VOD is likely still being processed. It returns false if the video
+// can be downloaded right away.
+func schedule(video *index.Video, result *youtube.Video, now time.Time) bool {
+	var start, end time.Time
+	if details := result.LiveStreamingDetails; details != nil {
+		start = parseTime(details.ScheduledStartTime)
+		end = parseTime(details.ActualEndTime)
+	}
+
+	var at time.Time
+
+	switch result.Snippet.LiveBroadcastContent {
+	case "upcoming":
+		switch {
+		case start.IsZero():
+			at = now.Add(liveRecheckInterval)
+		case now.Sub(start) > abandonedAfter:
+			video.Status = index.StatusSkipped
+			video.Reason = "upcoming, never started"
+			return true
+		case start.After(now):
+			at = start
+		default:
+			at = now.Add(liveRecheckInterval)
+		}
+	case "live":
+		at = now.Add(liveRecheckInterval)
+	default:
+		if end.IsZero() || end.Add(vodProcessingDelay).Before(now) {
+			return false
+		}
+		at = end.Add(vodProcessingDelay)
+	}
+
+	video.Status = index.StatusScheduled
+	video.ScheduledAt = &at
+	return true
+}
+
+func parseTime(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}
 	}
+	return t
 }
 
 func logProgress(videos []*index.Video) {
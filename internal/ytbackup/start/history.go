@@ -5,6 +5,7 @@ import (
 
 	"github.com/rs/zerolog/log"
 	"mkuznets.com/go/ytbackup/internal/history"
+	"mkuznets.com/go/ytbackup/internal/index"
 	"mkuznets.com/go/ytbackup/internal/utils/ticker"
 )
 
@@ -23,7 +24,7 @@ func (cmd *Command) RunHistoryCrawler(ctx context.Context) error {
 				return err
 			}
 
-			n, err := cmd.Index.Push(videos)
+			n, err := cmd.Index.Push(index.Source{Kind: index.SourceHistory}, videos)
 			if err != nil {
 				return err
 			}
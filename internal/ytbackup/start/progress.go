@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/hpcloud/tail"
-	"github.com/rs/zerolog/log"
 	"golang.org/x/time/rate"
 	"mkuznets.com/go/ytbackup/internal/utils"
 )
@@ -27,12 +26,8 @@ type Progress struct {
 	Finished   bool
 }
 
-func trackProgress(ctx context.Context, cancel context.CancelFunc, path string) {
-	logCtx := log.With()
-	if videoID := ctx.Value(videoIDKey{}); videoID != nil {
-		logCtx = logCtx.Str("id", videoID.(string))
-	}
-	logger := logCtx.Logger()
+func trackProgress(ctx context.Context, cancel context.CancelFunc, w *worker, path string) {
+	logger := w.logger.With().Str("id", w.video()).Logger()
 
 	cfg := tail.Config{Follow: true, Logger: tail.DiscardingLogger}
 
@@ -102,6 +97,7 @@ Loop:
 			if err := json.NewDecoder(strings.NewReader(raw)).Decode(&progress); err != nil {
 				continue
 			}
+			w.update(&progress)
 
 			if limiter.Allow() || progress.Finished {
 				ev := logger.Info().Str("pc", progress.Done)
@@ -0,0 +1,46 @@
+package start
+
+import (
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/api/youtube/v3"
+	"mkuznets.com/go/ytbackup/internal/index"
+)
+
+// refreshDue reports whether the metadata of the video has not been
+// fetched within the refresh interval.
+func (cmd *Command) refreshDue(video *index.Video, now time.Time) bool {
+	interval := cmd.Config.Metadata.RefreshInterval
+	return interval > 0 && (video.RefreshedAt == nil || !video.RefreshedAt.After(now.Add(-interval)))
+}
+
+// refresh saves metadata of downloaded videos fetched again to keep statistics
+// up to date and versions edited by creators. Results must have the parts of metaParts.
+func (cmd *Command) refresh(ids []string, results []*youtube.Video, now time.Time) error {
+	// Unavailable videos keep their last metadata, the auditor takes care of them.
+	metas := make(map[string]*index.Meta)
+	for _, id := range ids {
+		metas[id] = nil
+	}
+	for _, result := range results {
+		if _, ok := metas[result.Id]; !ok {
+			continue
+		}
+		meta, err := metaFromAPI(result)
+		if err != nil {
+			log.Err(err).Str("id", result.Id).Msg("Refresher: invalid metadata")
+			continue
+		}
+		metas[result.Id] = meta
+	}
+
+	edited, err := cmd.Index.RefreshMeta(metas, now)
+	if err != nil {
+		return err
+	}
+	if edited > 0 {
+		log.Info().Int("videos", edited).Msg("Refresher: edited metadata saved")
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package start
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/storages"
+	"mkuznets.com/go/ytbackup/internal/utils"
+	"mkuznets.com/go/ytbackup/internal/utils/ticker"
+)
+
+// RunReplicator periodically copies downloaded videos to additional storages
+// until each video has the configured number of copies.
+func (cmd *Command) RunReplicator(ctx context.Context) error {
+	return ticker.New(cmd.Config.Replication.Interval).Do(ctx, func() error {
+		if err := cmd.replicateOnce(ctx); err != nil {
+			log.Err(err).Msg("Replicator error")
+		}
+		return nil
+	})
+}
+
+func (cmd *Command) replicateOnce(ctx context.Context) error {
+	factor := cmd.Config.Replication.Factor
+
+	videos := make([]*index.Video, 0)
+	err := cmd.Index.Iter(index.StatusDone, func(video *index.Video) error {
+		if len(video.Storages) < factor {
+			videos = append(videos, video)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(videos) == 0 {
+		return nil
+	}
+
+	log.Info().Int("videos", len(videos)).Int("factor", factor).Msg("Replicator: under-replicated videos found")
+
+	copied := 0
+	for _, video := range videos {
+		if ctx.Err() != nil {
+			break
+		}
+
+		for len(video.Storages) < factor {
+			src, dst := replicaStorages(cmd.Storages.List(), video)
+			if src == nil || dst == nil {
+				log.Debug().Str("id", video.ID).Msg("Replicator: no suitable storage")
+				break
+			}
+
+			if err := replicate(video, src, dst); err != nil {
+				log.Err(err).
+					Str("id", video.ID).
+					Str("src", src.ID).
+					Str("dst", dst.ID).
+					Msg("Replicator: copy failed")
+				break
+			}
+
+			storage := index.Storage{ID: dst.ID}
+			if err := cmd.Index.AddStorage(video.ID, storage); err != nil {
+				// The video may have been removed in the meantime, do not leave an unlisted copy
+				if e := storages.RemoveFiles(dst.Path, video.Files); e != nil {
+					log.Err(e).Str("id", video.ID).Str("storage", dst.ID).Msg("Replicator: could not remove copy")
+				}
+				return err
+			}
+			video.Storages = append(video.Storages, storage)
+			copied++
+
+			log.Info().Str("id", video.ID).Str("storage", dst.ID).Msg("Replicator: video copied")
+		}
+	}
+
+	log.Info().Int("copies", copied).Msg("Replicator: done")
+
+	return nil
+}
+
+// replicaStorages selects an online storage the video can be copied from
+// and a storage with enough free space it can be copied to.
+func replicaStorages(ready []*storages.Ready, video *index.Video) (src, dst *storages.Ready) {
+	size := video.Size()
+
+	for _, r := range ready {
+		if video.HasStorage(r.ID) {
+			if src == nil {
+				src = r
+			}
+			continue
+		}
+		if r.Fits(size) && (dst == nil || r.Available > dst.Available) {
+			dst = r
+		}
+	}
+
+	return src, dst
+}
+
+func replicate(video *index.Video, src, dst *storages.Ready) error {
+	for _, f := range video.Files {
+		srcPath := filepath.Join(src.Path, f.Path)
+		dstPath := filepath.Join(dst.Path, f.Path)
+
+		if err := storages.CopyFile(srcPath, dstPath, f.Hash); err != nil {
+			return fmt.Errorf("could not copy %s: %v", f.Path, err)
+		}
+		log.Debug().
+			Str("id", video.ID).
+			Str("path", f.Path).
+			Str("size", utils.IBytes(f.Size)).
+			Msg("Replicator: file copied")
+	}
+	return nil
+}
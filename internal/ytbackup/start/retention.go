@@ -0,0 +1,121 @@
+package start
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/storages"
+	"mkuznets.com/go/ytbackup/internal/utils/ticker"
+)
+
+const retentionCheckInterval = time.Hour
+
+var errKept = errors.New("video kept")
+
+// RunRetention periodically removes files of downloaded videos whose
+// sources have retention policies that are over.
+func (cmd *Command) RunRetention(ctx context.Context) error {
+	return ticker.New(retentionCheckInterval).Do(ctx, func() error {
+		roots := make(map[string]string)
+		for _, r := range cmd.Storages.List() {
+			roots[r.ID] = r.Path
+		}
+
+		now := time.Now()
+		expired := make([]*index.Video, 0)
+
+		// Videos left retiring by an interrupted run are removed first
+		err := cmd.Index.Iter(index.StatusRetiring, func(video *index.Video) error {
+			expired = append(expired, video)
+			return nil
+		})
+		if err != nil {
+			log.Err(err).Msg("Index error")
+			return nil
+		}
+
+		err = cmd.Index.Iter(index.StatusDone, func(video *index.Video) error {
+			// Videos gone from Youtube are the backups worth keeping most
+			if !video.Rescued() && cmd.Config.Expired(video, now) {
+				expired = append(expired, video)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Err(err).Msg("Index error")
+			return nil
+		}
+
+		removed := 0
+		for _, video := range expired {
+			if ctx.Err() != nil {
+				break
+			}
+			if cmd.removeVideo(video, roots) {
+				removed++
+			}
+		}
+
+		if removed > 0 {
+			log.Info().Int("videos", removed).Msg("Retention: expired videos removed")
+		}
+		return nil
+	})
+}
+
+// removeVideo deletes the files of the video from all its storages.
+// The video is marked retiring first, so that a removal interrupted by a failure
+// is finished by the next check. Videos with offline storages are kept until then.
+func (cmd *Command) removeVideo(video *index.Video, roots map[string]string) bool {
+	if video.Status == index.StatusDone {
+		current, err := cmd.Index.BeginRetire(video.ID, "removed by retention policy", func(current *index.Video) error {
+			if current.Rescued() {
+				return errKept
+			}
+			return checkOnline(current, roots)
+		})
+		switch {
+		case errors.Is(err, errKept):
+			return false
+		case err != nil:
+			log.Err(err).Str("id", video.ID).Msg("Retention: could not remove video")
+			return false
+		case current == nil:
+			return false
+		}
+		video = current
+	}
+
+	if errors.Is(checkOnline(video, roots), errKept) {
+		return false
+	}
+
+	for _, st := range video.Storages {
+		if err := storages.RemoveFiles(roots[st.ID], video.Files); err != nil {
+			log.Err(err).Str("id", video.ID).Str("storage", st.ID).Msg("Retention: could not remove video files")
+			return false
+		}
+	}
+
+	if err := cmd.Index.Retire(video.ID); err != nil {
+		log.Err(err).Str("id", video.ID).Msg("Index error")
+		return false
+	}
+
+	log.Info().Str("id", video.ID).Msg("Retention: video removed")
+	return true
+}
+
+// checkOnline returns errKept if some storages of the video are offline.
+func checkOnline(video *index.Video, roots map[string]string) error {
+	for _, st := range video.Storages {
+		if _, ok := roots[st.ID]; !ok {
+			log.Debug().Str("id", video.ID).Str("storage", st.ID).Msg("Retention: storage is offline, video kept")
+			return errKept
+		}
+	}
+	return nil
+}
@@ -2,7 +2,10 @@ package start
 
 import (
 	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/control"
+	"mkuznets.com/go/ytbackup/internal/index"
 	"mkuznets.com/go/ytbackup/internal/python"
+	yt "mkuznets.com/go/ytbackup/internal/youtube"
 	"mkuznets.com/go/ytbackup/internal/ytbackup"
 )
 
@@ -10,9 +13,43 @@ type Command struct {
 	ytbackup.Command
 	DisableDownload bool `long:"disable-download" description:"Do not download videos" env:"YTBACKUP_DISABLE_DOWNLOAD"`
 	Python          *python.Python
+	Youtube         *yt.Client
+	// Index shadows the common index: the daemon always owns the database.
+	Index   *index.Index
+	workers []*worker
 }
 
 func (cmd *Command) Execute([]string) error {
+	idx, err := cmd.LocalIndex()
+	if err != nil {
+		return err
+	}
+	cmd.Index = idx
+
+	service, err := yt.NewService(cmd.Ctx, cmd.Config.Youtube.OAuth.Token())
+	if err != nil {
+		return err
+	}
+	quota := &cmd.Config.Youtube.Quota
+	cmd.Youtube = yt.NewClient(service, cmd.Index, quota.DailyBudget, quota.Reserve)
+
+	// Workers are created before the control socket reports their state
+	if !cmd.DisableDownload {
+		for i := 1; i <= cmd.Config.Downloader.Workers; i++ {
+			cmd.workers = append(cmd.workers, newWorker(i))
+		}
+	}
+
+	ctl, err := control.Listen(cmd.Config.Dirs.ControlSocket(), cmd.Index, cmd.Workers)
+	if err != nil {
+		return err
+	}
+	cmd.Wg.Add(1)
+	go func() {
+		defer cmd.Wg.Done()
+		ctl.Serve(cmd.Ctx)
+	}()
+
 	pyConf := &cmd.Config.Python
 
 	cmd.Python = python.New(
@@ -21,7 +58,6 @@ func (cmd *Command) Execute([]string) error {
 		python.WithYDLUpdateInterval(pyConf.YoutubeDL.UpdateInterval),
 		python.WithYDLLite(pyConf.YoutubeDL.Lite),
 		python.WithYDLVersion(pyConf.YoutubeDL.Version),
-		python.WithYDLOptions(pyConf.YoutubeDL.Options),
 	)
 
 	if err := cmd.Python.Init(cmd.CriticalCtx); err != nil {
@@ -61,8 +97,108 @@ func (cmd *Command) Execute([]string) error {
 		}()
 	}
 
+	if cmd.Config.Sources.Channels.Enabled() {
+		cmd.Wg.Add(1)
+		go func() {
+			defer cmd.Wg.Done()
+			log.Info().
+				Stringer("interval", cmd.Config.Sources.UpdateInterval).
+				Msg("Channels crawler: starting")
+
+			if err := cmd.RunChannelCrawler(cmd.Ctx); err != nil {
+				log.Err(err).Msg("Channels crawler")
+				return
+			}
+			log.Info().Msg("Channels crawler stopped")
+		}()
+	}
+
+	cmd.Wg.Add(1)
+	go func() {
+		defer cmd.Wg.Done()
+		if err := cmd.RunVolumeTracker(cmd.Ctx); err != nil {
+			log.Err(err).Msg("Volume tracker")
+		}
+	}()
+
+	if cmd.Config.Replication.Factor > 1 {
+		cmd.Wg.Add(1)
+		go func() {
+			defer cmd.Wg.Done()
+			log.Info().
+				Int("factor", cmd.Config.Replication.Factor).
+				Stringer("interval", cmd.Config.Replication.Interval).
+				Msg("Replicator: starting")
+
+			if err := cmd.RunReplicator(cmd.Ctx); err != nil {
+				log.Err(err).Msg("Replicator")
+				return
+			}
+			log.Info().Msg("Replicator stopped")
+		}()
+	}
+
+	if cmd.Config.Audit.Interval > 0 || cmd.Config.Metadata.RefreshInterval > 0 {
+		cmd.Wg.Add(1)
+		go func() {
+			defer cmd.Wg.Done()
+			log.Info().
+				Stringer("audit_interval", cmd.Config.Audit.Interval).
+				Stringer("refresh_interval", cmd.Config.Metadata.RefreshInterval).
+				Msg("Video checker: starting")
+
+			if err := cmd.RunChecker(cmd.Ctx); err != nil {
+				log.Err(err).Msg("Video checker")
+				return
+			}
+			log.Info().Msg("Video checker stopped")
+		}()
+	}
+
+	if cmd.Config.HasRetention() {
+		cmd.Wg.Add(1)
+		go func() {
+			defer cmd.Wg.Done()
+			log.Info().Msg("Retention: starting")
+
+			if err := cmd.RunRetention(cmd.Ctx); err != nil {
+				log.Err(err).Msg("Retention")
+				return
+			}
+			log.Info().Msg("Retention stopped")
+		}()
+	}
+
+	if cmd.Config.Comments.Enable {
+		cmd.Wg.Add(1)
+		go func() {
+			defer cmd.Wg.Done()
+			log.Info().Stringer("interval", cmd.Config.Comments.Interval).Msg("Comments archiver: starting")
+
+			if err := cmd.RunCommentsArchiver(cmd.Ctx); err != nil {
+				log.Err(err).Msg("Comments archiver")
+				return
+			}
+			log.Info().Msg("Comments archiver stopped")
+		}()
+	}
+
+	if cmd.Config.ChannelArchive.Enable {
+		cmd.Wg.Add(1)
+		go func() {
+			defer cmd.Wg.Done()
+			log.Info().Stringer("interval", cmd.Config.ChannelArchive.Interval).Msg("Channel archiver: starting")
+
+			if err := cmd.RunChannelArchiver(cmd.Ctx); err != nil {
+				log.Err(err).Msg("Channel archiver")
+				return
+			}
+			log.Info().Msg("Channel archiver stopped")
+		}()
+	}
+
 	if !cmd.DisableDownload {
-		log.Info().Msg("Downloader: starting")
+		log.Info().Int("workers", cmd.Config.Downloader.Workers).Msg("Downloader: starting")
 
 		cmd.Wg.Add(1)
 		go func() {
@@ -84,3 +220,12 @@ func (cmd *Command) Execute([]string) error {
 
 	return nil
 }
+
+// Workers returns the current state of download workers.
+func (cmd *Command) Workers() []*control.WorkerStatus {
+	statuses := make([]*control.WorkerStatus, 0, len(cmd.workers))
+	for _, w := range cmd.workers {
+		statuses = append(statuses, w.status())
+	}
+	return statuses
+}
@@ -0,0 +1,44 @@
+package start
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/utils/ticker"
+	"mkuznets.com/go/ytbackup/internal/ytbackup"
+)
+
+const volumeCheckInterval = 5 * time.Minute
+
+// RunVolumeTracker periodically records online storages in the index
+// and keeps their manifests up to date.
+func (cmd *Command) RunVolumeTracker(ctx context.Context) error {
+	// Digests of the last written manifests, to avoid rewriting unchanged ones.
+	written := make(map[string]string)
+
+	return ticker.New(volumeCheckInterval).Do(ctx, func() error {
+		if err := cmd.trackVolumesOnce(written); err != nil {
+			log.Err(err).Msg("Volume tracker error")
+		}
+		return nil
+	})
+}
+
+func (cmd *Command) trackVolumesOnce(written map[string]string) error {
+	ready := cmd.Storages.List()
+	if len(ready) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	for _, r := range ready {
+		volume := &index.Volume{ID: r.ID, Path: r.Path, LastSeen: now, Total: r.Total, Free: r.Free}
+		if err := cmd.Index.PutVolume(volume); err != nil {
+			return err
+		}
+	}
+
+	return ytbackup.WriteManifests(cmd.Index, ready, written)
+}
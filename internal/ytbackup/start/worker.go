@@ -0,0 +1,65 @@
+package start
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/control"
+)
+
+// worker downloads one video at a time and keeps track of its progress.
+type worker struct {
+	id     int
+	logger zerolog.Logger
+
+	mu       sync.Mutex
+	videoID  string
+	started  time.Time
+	progress Progress
+}
+
+func newWorker(id int) *worker {
+	return &worker{
+		id:     id,
+		logger: log.With().Int("worker", id).Logger(),
+	}
+}
+
+func (w *worker) start(videoID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.videoID = videoID
+	w.started = time.Now()
+	w.progress = Progress{}
+}
+
+func (w *worker) finish() {
+	w.start("")
+}
+
+func (w *worker) update(p *Progress) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.progress = *p
+}
+
+func (w *worker) video() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.videoID
+}
+
+func (w *worker) status() *control.WorkerStatus {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return &control.WorkerStatus{
+		Worker:     w.id,
+		VideoID:    w.videoID,
+		Started:    w.started,
+		Total:      w.progress.Total,
+		Downloaded: w.progress.Downloaded,
+		Done:       w.progress.Done,
+	}
+}
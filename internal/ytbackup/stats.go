@@ -0,0 +1,83 @@
+package ytbackup
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"mkuznets.com/go/tabwriter"
+	"mkuznets.com/go/ytbackup/internal/control"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/utils"
+	yt "mkuznets.com/go/ytbackup/internal/youtube"
+)
+
+type StatsCommand struct {
+	Command
+}
+
+func (cmd *StatsCommand) Execute([]string) error {
+	counts, err := cmd.Index.Count()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	day := yt.QuotaDay(now)
+	spent, err := cmd.Index.Quota(day)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 10, 1, 2, ' ', 0)
+
+	statuses := make([]string, 0, len(counts))
+	total := 0
+	for st, n := range counts {
+		statuses = append(statuses, string(st))
+		total += n
+	}
+	sort.Strings(statuses)
+
+	fmt.Fprintln(tw, "Videos")
+	for _, st := range statuses {
+		fmt.Fprintf(tw, "  %s\t%d\n", st, counts[index.Status(st)])
+	}
+	fmt.Fprintf(tw, "  TOTAL\t%d\n", total)
+
+	budget := cmd.Config.Youtube.Quota.DailyBudget
+	fmt.Fprintln(tw, "Youtube API quota")
+	fmt.Fprintf(tw, "  Day (Pacific Time)\t%s\n", day)
+	fmt.Fprintf(tw, "  Spent\t%d / %d units (%.1f%%)\n", spent, budget, float64(spent)*100/float64(budget))
+	fmt.Fprintf(tw, "  Reserved\t%d units\n", cmd.Config.Youtube.Quota.Reserve)
+	fmt.Fprintf(tw, "  Reset in\t%s\n", yt.NextReset(now).Sub(now).Truncate(time.Minute))
+
+	// Workers are only known to the running instance
+	if client, ok := cmd.Index.(*control.Client); ok {
+		workers, err := client.Workers()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(tw, "Download workers")
+		for _, w := range workers {
+			fmt.Fprintf(tw, "  #%d\t%s\n", w.Worker, workerState(w, now))
+		}
+	}
+
+	return tw.Flush()
+}
+
+func workerState(w *control.WorkerStatus, now time.Time) string {
+	if w.VideoID == "" {
+		return "idle"
+	}
+	s := fmt.Sprintf("%s, for %s", w.VideoID, now.Sub(w.Started).Truncate(time.Second))
+	if w.Total > 0 {
+		s += fmt.Sprintf(", %s / %s", utils.IBytes(w.Downloaded), utils.IBytes(w.Total))
+	}
+	if w.Done != "" {
+		s += fmt.Sprintf(" (%s)", w.Done)
+	}
+	return s
+}
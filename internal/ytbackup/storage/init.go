@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/storages"
+	"mkuznets.com/go/ytbackup/internal/utils"
+	"mkuznets.com/go/ytbackup/internal/ytbackup"
+)
+
+// InitCommand explicitly initialises a configured path where a known volume
+// used to be, e.g. after a removable drive has been replaced.
+type InitCommand struct {
+	Args struct {
+		Path string `positional-arg-name:"PATH"`
+	} `positional-args:"1" required:"1"`
+	ytbackup.Command
+}
+
+func (cmd *InitCommand) Execute([]string) error {
+	path := utils.MustExpand(cmd.Args.Path)
+
+	configured := false
+	for _, st := range cmd.Config.Storages {
+		configured = configured || st.Path == path
+	}
+	if !configured {
+		return fmt.Errorf("%s is not a configured storage path", path)
+	}
+
+	if err := utils.IsWritableDir(path); err != nil {
+		return err
+	}
+
+	id, err := storages.Init(path)
+	if err != nil {
+		return err
+	}
+
+	log.Info().Str("path", path).Str("id", id).Msg("Storage initialised")
+	return nil
+}
@@ -0,0 +1,427 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/storages"
+	"mkuznets.com/go/ytbackup/internal/utils"
+	"mkuznets.com/go/ytbackup/internal/ytbackup"
+)
+
+type MigrateCommand struct {
+	From      string   `long:"from" value-name:"ID" description:"Move all videos off the storage"`
+	To        []string `long:"to" value-name:"ID" description:"Allowed destination storage (default: all online storages)"`
+	Rebalance bool     `long:"rebalance" description:"Move videos to even out usage across online storages"`
+	Tolerance float64  `long:"tolerance" default:"5" description:"Acceptable usage difference in percent for --rebalance"`
+	DryRun    bool     `long:"dry-run" description:"Only show what would be moved"`
+	ytbackup.Command
+	idx     *index.Index
+	journal *journal
+}
+
+func (cmd *MigrateCommand) Execute([]string) error {
+	if (cmd.From == "") == !cmd.Rebalance {
+		return errors.New("either --from or --rebalance is required")
+	}
+
+	idx, err := cmd.LocalIndex()
+	if err != nil {
+		return err
+	}
+	cmd.idx = idx
+	cmd.journal = newJournal(cmd.Config.Dirs.Metadata())
+
+	ready := cmd.Storages.List()
+
+	if err := cmd.recover(ready); err != nil {
+		return fmt.Errorf("could not recover interrupted migration: %v", err)
+	}
+
+	videos := make([]*index.Video, 0)
+	err = cmd.idx.Iter(index.StatusDone, func(video *index.Video) error {
+		videos = append(videos, video)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if cmd.Rebalance {
+		err = cmd.rebalance(ready, videos)
+	} else {
+		err = cmd.migrate(ready, videos)
+	}
+
+	if !cmd.DryRun {
+		if e := ytbackup.WriteManifests(cmd.idx, ready, nil); e != nil {
+			log.Err(e).Msg("Could not update manifests")
+		}
+	}
+	return err
+}
+
+// recover finishes a move that was interrupted after the index had been updated,
+// or removes the partial copy otherwise. Files are only removed from a storage
+// the index does not list for the video.
+func (cmd *MigrateCommand) recover(ready []*storages.Ready) error {
+	m, err := cmd.journal.Pending()
+	if err != nil || m == nil {
+		return err
+	}
+
+	video, err := cmd.idx.Find(m.ID)
+	if err != nil {
+		return err
+	}
+
+	// Files are left on both storages if the index lists both,
+	// e.g. when the replicator has copied the video in the meantime.
+	if video != nil && !(video.HasStorage(m.To) && video.HasStorage(m.From)) {
+		leftover := m.To
+		if video.HasStorage(m.To) {
+			leftover = m.From
+		}
+
+		if r := find(ready, leftover); r != nil {
+			if err := storages.RemoveFiles(r.Path, video.Files); err != nil {
+				return err
+			}
+			log.Info().Str("id", video.ID).Str("storage", leftover).Msg("Interrupted move cleaned up")
+		} else {
+			log.Warn().Str("id", video.ID).Str("storage", leftover).Msg("Storage is offline, leftover files not removed")
+		}
+	}
+
+	return cmd.journal.Done()
+}
+
+func (cmd *MigrateCommand) migrate(ready []*storages.Ready, videos []*index.Video) error {
+	from := find(ready, cmd.From)
+	if from == nil {
+		log.Warn().Str("storage", cmd.From).Msg("Storage is offline, videos are copied from other storages")
+	}
+
+	moved, failed := 0, 0
+	for _, video := range videos {
+		if cmd.Ctx.Err() != nil {
+			break
+		}
+		if !video.HasStorage(cmd.From) {
+			continue
+		}
+
+		src := from
+		if src == nil {
+			for _, r := range ready {
+				if video.HasStorage(r.ID) {
+					src = r
+					break
+				}
+			}
+		}
+		if src == nil {
+			log.Warn().Str("id", video.ID).Msg("No online copy of the video")
+			failed++
+			continue
+		}
+
+		dst := cmd.destination(ready, video)
+		if dst == nil {
+			log.Warn().Str("id", video.ID).Str("size", utils.IBytes(video.Size())).Msg("No suitable destination storage")
+			failed++
+			continue
+		}
+
+		if err := cmd.move(video, src, from, cmd.From, dst); err != nil {
+			log.Err(err).Str("id", video.ID).Str("dst", dst.ID).Msg("Could not move video")
+			failed++
+			continue
+		}
+		moved++
+	}
+
+	if cmd.Ctx.Err() == nil {
+		if err := cmd.migrateChannels(ready, from); err != nil {
+			return err
+		}
+	}
+
+	log.Info().Int("moved", moved).Int("failed", failed).Msg("Migration done")
+
+	if failed > 0 {
+		return fmt.Errorf("%d videos could not be moved, run the command again to retry", failed)
+	}
+	return nil
+}
+
+// migrateChannels moves channel archives off the storage. Archives on an offline
+// storage are left in place to be written anew on the next update.
+func (cmd *MigrateCommand) migrateChannels(ready []*storages.Ready, from *storages.Ready) error {
+	channels, err := cmd.idx.Channels()
+	if err != nil {
+		return err
+	}
+
+	for _, ch := range channels {
+		if ch.Storage != cmd.From {
+			continue
+		}
+
+		var dst *storages.Ready
+		for _, r := range ready {
+			if r.ID != cmd.From && cmd.allowed(r.ID) && cmd.Storages.Accepts(r, ch.ID) && (dst == nil || r.Available > dst.Available) {
+				dst = r
+			}
+		}
+		if dst == nil {
+			log.Warn().Str("channel", ch.ID).Msg("No suitable destination storage for channel archive")
+			continue
+		}
+
+		log.Info().
+			Str("channel", ch.ID).
+			Str("from", cmd.From).
+			Str("to", dst.ID).
+			Bool("dry_run", cmd.DryRun).
+			Msg("Moving channel archive")
+
+		if cmd.DryRun {
+			continue
+		}
+
+		if from != nil {
+			for _, f := range ch.Files {
+				if err := storages.CopyFile(filepath.Join(from.Path, f.Path), filepath.Join(dst.Path, f.Path), f.Hash); err != nil {
+					return fmt.Errorf("could not copy %s: %v", f.Path, err)
+				}
+			}
+		}
+
+		ch.Storage = dst.ID
+		if from == nil {
+			ch.Files = nil
+			ch.SyncedAt = time.Time{}
+		}
+		if err := cmd.idx.PutChannel(ch); err != nil {
+			return err
+		}
+
+		if from != nil {
+			if err := storages.RemoveFiles(from.Path, ch.Files); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// rebalance moves videos from the most used storage to the least used ones
+// until the difference in usage is within the tolerance.
+func (cmd *MigrateCommand) rebalance(ready []*storages.Ready, videos []*index.Video) error {
+	if len(ready) < 2 {
+		return errors.New("at least two online storages are required to rebalance")
+	}
+
+	moved := 0
+
+	for cmd.Ctx.Err() == nil {
+		sorted := make([]*storages.Ready, len(ready))
+		copy(sorted, ready)
+		sort.Slice(sorted, func(i, j int) bool { return usage(sorted[i]) > usage(sorted[j]) })
+
+		src := sorted[0]
+		var video *index.Video
+		var dst *storages.Ready
+
+		for i := len(sorted) - 1; i > 0 && video == nil; i-- {
+			if (usage(src)-usage(sorted[i]))*100 <= cmd.Tolerance {
+				break
+			}
+			if !cmd.allowed(sorted[i].ID) {
+				continue
+			}
+			dst = sorted[i]
+			video = cmd.pickForBalance(videos, src, dst)
+		}
+		if video == nil {
+			break
+		}
+
+		if err := cmd.move(video, src, src, src.ID, dst); err != nil {
+			return fmt.Errorf("could not move %s: %v", video.ID, err)
+		}
+		moved++
+	}
+
+	log.Info().Int("moved", moved).Msg("Rebalance done")
+
+	for _, r := range ready {
+		log.Info().
+			Str("storage", r.ID).
+			Str("path", r.Path).
+			Str("usage", fmt.Sprintf("%.1f%%", usage(r)*100)).
+			Msg("Storage usage")
+	}
+
+	return nil
+}
+
+// pickForBalance returns the largest video that can be moved from src to dst
+// without making dst more used than src.
+func (cmd *MigrateCommand) pickForBalance(videos []*index.Video, src, dst *storages.Ready) *index.Video {
+	if src.Total == 0 || dst.Total == 0 {
+		return nil
+	}
+
+	var selected *index.Video
+	for _, video := range videos {
+		if !video.HasStorage(src.ID) || video.HasStorage(dst.ID) {
+			continue
+		}
+		size := video.Size()
+		if selected != nil && size <= selected.Size() {
+			continue
+		}
+		if !dst.Fits(size) || !cmd.Storages.Accepts(dst, channelID(video)) {
+			continue
+		}
+
+		if size > src.Total-src.Free {
+			continue
+		}
+		srcUsed := float64(src.Total-src.Free-size) / float64(src.Total)
+		dstUsed := float64(dst.Total-dst.Free+size) / float64(dst.Total)
+		if dstUsed > srcUsed {
+			continue
+		}
+
+		selected = video
+	}
+
+	return selected
+}
+
+// move copies the video from src to dst, records it in the index and removes
+// the files from the storage it is moved off, if that storage is online.
+func (cmd *MigrateCommand) move(video *index.Video, src, from *storages.Ready, fromID string, dst *storages.Ready) error {
+	size := video.Size()
+
+	log.Info().
+		Str("id", video.ID).
+		Str("from", fromID).
+		Str("to", dst.ID).
+		Str("size", utils.IBytes(size)).
+		Bool("dry_run", cmd.DryRun).
+		Msg("Moving video")
+
+	if !cmd.DryRun {
+		if err := cmd.journal.Begin(&move{ID: video.ID, From: fromID, To: dst.ID}); err != nil {
+			return err
+		}
+
+		for _, f := range video.Files {
+			err := storages.CopyFile(filepath.Join(src.Path, f.Path), filepath.Join(dst.Path, f.Path), f.Hash)
+			if err != nil {
+				if e := storages.RemoveFiles(dst.Path, video.Files); e != nil {
+					log.Err(e).Str("id", video.ID).Msg("Could not remove partial copy")
+				}
+				_ = cmd.journal.Done()
+				return fmt.Errorf("could not copy %s: %v", f.Path, err)
+			}
+		}
+
+		if err := cmd.idx.MoveStorage(video.ID, fromID, dst.ID); err != nil {
+			return err
+		}
+
+		if from != nil {
+			if err := storages.RemoveFiles(from.Path, video.Files); err != nil {
+				return err
+			}
+		}
+
+		if err := cmd.journal.Done(); err != nil {
+			return err
+		}
+	}
+
+	kept := make([]index.Storage, 0, len(video.Storages))
+	for _, s := range video.Storages {
+		if s.ID != fromID {
+			kept = append(kept, s)
+		}
+	}
+	video.Storages = append(kept, index.Storage{ID: dst.ID})
+
+	dst.Free -= size
+	dst.Available -= size
+	if from != nil {
+		from.Free += size
+		from.Available += size
+	}
+
+	return nil
+}
+
+// destination selects a storage the video can be moved to, preferring
+// the one with the most available space.
+func (cmd *MigrateCommand) destination(ready []*storages.Ready, video *index.Video) *storages.Ready {
+	var dst *storages.Ready
+	size := video.Size()
+
+	for _, r := range ready {
+		if video.HasStorage(r.ID) || !cmd.allowed(r.ID) {
+			continue
+		}
+		if !r.Fits(size) || !cmd.Storages.Accepts(r, channelID(video)) {
+			continue
+		}
+		if dst == nil || r.Available > dst.Available {
+			dst = r
+		}
+	}
+
+	return dst
+}
+
+func (cmd *MigrateCommand) allowed(id string) bool {
+	if len(cmd.To) == 0 {
+		return true
+	}
+	for _, to := range cmd.To {
+		if to == id {
+			return true
+		}
+	}
+	return false
+}
+
+func find(ready []*storages.Ready, id string) *storages.Ready {
+	for _, r := range ready {
+		if r.ID == id {
+			return r
+		}
+	}
+	return nil
+}
+
+func usage(r *storages.Ready) float64 {
+	if r.Total == 0 {
+		return 0
+	}
+	return float64(r.Total-r.Free) / float64(r.Total)
+}
+
+func channelID(video *index.Video) string {
+	if video.Meta == nil {
+		return ""
+	}
+	return video.Meta.ChannelID
+}
@@ -0,0 +1,6 @@
+package storage
+
+type Command struct {
+	Migrate *MigrateCommand `command:"migrate" description:"Move videos between storages"`
+	Init    *InitCommand    `command:"init" description:"Initialise a path as a new storage"`
+}
@@ -0,0 +1,67 @@
+package ytbackup
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"mkuznets.com/go/tabwriter"
+)
+
+type SyncCommand struct {
+	Full bool `long:"full" description:"Walk all pages of playlists on the next crawl"`
+	Args struct {
+		Playlists []string `positional-arg-name:"PLAYLIST" description:"Playlist ID or title (default: all)"`
+	} `positional-args:"1"`
+	Command
+}
+
+func (cmd *SyncCommand) Execute([]string) error {
+	playlists, err := cmd.Index.Playlists()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Full {
+		sort.Slice(playlists, func(i, j int) bool { return playlists[i].Title < playlists[j].Title })
+
+		tw := tabwriter.NewWriter(os.Stdout, 10, 1, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tTITLE\tITEMS\tLAST SCAN\tLAST FULL SCAN\tFULL SCAN REQUESTED")
+		for _, p := range playlists {
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\t%t\n",
+				p.ID, p.Title, p.ItemCount, formatTime(p.LastScan), formatTime(p.LastFullScan), p.FullScanRequested)
+		}
+		return tw.Flush()
+	}
+
+	ids := make([]string, 0, len(cmd.Args.Playlists))
+	for _, arg := range cmd.Args.Playlists {
+		found := false
+		for _, p := range playlists {
+			if p.ID == arg || p.Title == arg {
+				ids = append(ids, p.ID)
+				found = true
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown playlist: %s", arg)
+		}
+	}
+
+	n, err := cmd.Index.RequestFullScan(ids...)
+	if err != nil {
+		return err
+	}
+	log.Info().Int("count", n).Msg("Full scan requested, it will be performed on the next crawl")
+
+	return nil
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
@@ -0,0 +1,99 @@
+package ytbackup
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"mkuznets.com/go/tabwriter"
+	"mkuznets.com/go/ytbackup/internal/index"
+	"mkuznets.com/go/ytbackup/internal/utils"
+)
+
+type StoragesCommand struct {
+	Command
+}
+
+type volumeRow struct {
+	id, path string
+	online   bool
+	lastSeen time.Time
+	free     uint64
+	videos   int
+	used     uint64
+}
+
+func (cmd *StoragesCommand) Execute([]string) error {
+	volumes, err := cmd.Index.Volumes()
+	if err != nil {
+		return err
+	}
+
+	rows := make(map[string]*volumeRow)
+	for _, v := range volumes {
+		rows[v.ID] = &volumeRow{id: v.ID, path: v.Path, lastSeen: v.LastSeen, free: v.Free}
+	}
+
+	now := time.Now()
+	unknown := make([]*volumeRow, 0)
+
+	for _, state := range cmd.Storages.Probe() {
+		if state.ID == "" {
+			unknown = append(unknown, &volumeRow{id: "-", path: state.Path})
+			continue
+		}
+		row, ok := rows[state.ID]
+		if !ok {
+			row = &volumeRow{id: state.ID}
+			rows[state.ID] = row
+		}
+		row.path = state.Path
+		if state.Online {
+			row.online = true
+			row.lastSeen = now
+			row.free = state.Free
+		}
+	}
+
+	err = cmd.Index.Iter(index.StatusDone, func(video *index.Video) error {
+		for _, st := range video.Storages {
+			row, ok := rows[st.ID]
+			if !ok {
+				row = &volumeRow{id: st.ID, path: "-"}
+				rows[st.ID] = row
+			}
+			row.videos++
+			row.used += video.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	sorted := make([]*volumeRow, 0, len(rows))
+	for _, row := range rows {
+		sorted = append(sorted, row)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].path < sorted[j].path })
+	sorted = append(sorted, unknown...)
+
+	tw := tabwriter.NewWriter(os.Stdout, 10, 1, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tPATH\tSTATUS\tLAST SEEN\tVIDEOS\tUSED\tFREE")
+
+	for _, row := range sorted {
+		status := "offline"
+		if row.online {
+			status = "online"
+		}
+		free := "-"
+		if row.free > 0 {
+			free = utils.IBytes(row.free)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			row.id, row.path, status, formatTime(row.lastSeen), row.videos, utils.IBytes(row.used), free)
+	}
+
+	return tw.Flush()
+}
@@ -3,6 +3,7 @@
 import argparse
 import contextlib
 import copy
+import errno
 import glob
 import hashlib
 import http.client
@@ -10,13 +11,55 @@ import json
 import logging
 import os
 import shutil
+import socket
 import stat
 import sys
 import typing
 import urllib.error
 from unittest import mock
 
-SYSTEM_EXCS = (urllib.error.URLError, http.client.HTTPException, OSError)
+NETWORK_EXCS = (
+    urllib.error.URLError,
+    http.client.HTTPException,
+    socket.timeout,
+    ConnectionError,
+)
+
+# Failure reasons, must be in sync with python.Reason
+REASON_PRIVATE = "private"
+REASON_REMOVED = "removed"
+REASON_COPYRIGHT = "copyright"
+REASON_GEO = "geo"
+REASON_AGE_GATE = "age-gate"
+REASON_MEMBERS_ONLY = "members-only"
+REASON_PREMIERE = "premiere"
+REASON_NETWORK = "network"
+REASON_DISK_FULL = "disk-full"
+REASON_SYSTEM = "system"
+REASON_EXTRACTOR_BROKEN = "extractor-broken"
+REASON_UNKNOWN = "unknown"
+
+# Checked in order, the first match wins
+REASON_PATTERNS = (
+    (REASON_COPYRIGHT, ("copyright",)),
+    (REASON_MEMBERS_ONLY, ("members-only", "members only", "join this channel")),
+    (REASON_PRIVATE, ("video is private", "private video")),
+    (REASON_PREMIERE, ("premiere", "live event will begin", "recording is not available")),
+    (REASON_GEO, ("in your country", "from your location", "geo restrict")),
+    (REASON_AGE_GATE, ("confirm your age", "age-restricted", "inappropriate for some users")),
+    (
+        REASON_REMOVED,
+        (
+            "video has been removed",
+            "no longer available",
+            "account associated with this video has been terminated",
+            "video is unavailable",
+            "video unavailable",
+            "this video is not available",
+        ),
+    ),
+    (REASON_NETWORK, ("http error 429", "too many requests", "timed out")),
+)
 
 STDERR = sys.stderr
 
@@ -48,11 +91,43 @@ YDL_OPTIONS = {
 
 class Error(Exception):
     def __init__(self, *args, reason=None, **kwargs):
-        self.reason = reason or "unknown"
+        self.reason = reason or REASON_UNKNOWN
         # noinspection PyArgumentList
         super().__init__(*args, **kwargs)
 
 
+def classify(exc: BaseException) -> str:
+    """Returns a failure reason of the exception raised by youtube-dl."""
+    try:
+        from youtube_dl.utils import ExtractorError, GeoRestrictedError
+    except ImportError:
+        return REASON_EXTRACTOR_BROKEN
+
+    cause = exc
+    exc_info = getattr(exc, "exc_info", None)
+    if exc_info and exc_info[1] is not None:
+        cause = exc_info[1]
+
+    if isinstance(cause, OSError) and cause.errno in (errno.ENOSPC, errno.EDQUOT):
+        return REASON_DISK_FULL
+    if isinstance(cause, GeoRestrictedError):
+        return REASON_GEO
+
+    text = str(exc).lower()
+    for reason, patterns in REASON_PATTERNS:
+        if any(p in text for p in patterns):
+            return reason
+
+    if isinstance(cause, NETWORK_EXCS):
+        return REASON_NETWORK
+    if isinstance(cause, OSError):
+        return REASON_SYSTEM
+    if isinstance(cause, ExtractorError) and not cause.expected:
+        return REASON_EXTRACTOR_BROKEN
+
+    return REASON_UNKNOWN
+
+
 def json_dump(data, f: typing.TextIO):
     json.dump(
         data, f, indent=2, skipkeys=True, ensure_ascii=False, default=lambda x: None,
@@ -122,9 +197,61 @@ def sha256sum(filename: str, logger: logging.Logger) -> str:
     return h.hexdigest()
 
 
+def ydl_options(logger: logging.Logger, custom: typing.Optional[str], **kwargs) -> dict:
+    custom_opts = json.loads(custom or "{}")
+    assert isinstance(custom_opts, dict)
+
+    opts = copy.copy(YDL_OPTIONS)
+    opts.update(logger=logger, **kwargs)
+
+    if custom_opts:
+        logger.info("Custom youtube-dl options: %s", custom_opts)
+        opts.update(custom_opts)
+
+    return opts
+
+
+def estimate_size(info: dict) -> int:
+    """Returns the total size of the selected formats, or 0 if it is unknown."""
+    total = 0
+    for fmt in info.get("requested_formats") or [info]:
+        size = fmt.get("filesize") or fmt.get("filesize_approx")
+        if not size:
+            return 0
+        total += size
+    return int(total)
+
+
 # ------------------------------------------------------------------------------
 
 
+class Estimate:
+    def __init__(self, args: argparse.Namespace):
+        self.url = args.url
+        self.logger = get_logger(args.log)
+
+        cache_dir = args.cache
+        if cache_dir:
+            os.makedirs(cache_dir, exist_ok=True)
+
+        self.opts = ydl_options(self.logger, args.options, cachedir=cache_dir or False)
+
+    def execute(self) -> typing.Any:
+        import youtube_dl
+
+        ydl = youtube_dl.YoutubeDL(self.opts)
+
+        try:
+            info = ydl.extract_info(self.url, download=False)
+        except youtube_dl.DownloadError as exc:
+            raise Error(str(exc), reason=classify(exc)) from exc
+
+        if not info:
+            raise Error("result is empty")
+
+        return {"id": info.get("id"), "size": estimate_size(info)}
+
+
 class Download:
     def __init__(self, args: argparse.Namespace):
         self.url = args.url
@@ -132,8 +259,10 @@ class Download:
 
         # ----------------------------------------------------------------------
 
+        # The destination directory may be shared with other videos
         self.dest_dir = os.path.abspath(os.path.expanduser(args.dst))
-        os.makedirs(os.path.dirname(self.dest_dir), exist_ok=True)
+        os.makedirs(self.dest_dir, exist_ok=True)
+        self.name = args.name
 
         self.root = os.path.abspath(os.path.expanduser(args.root))
 
@@ -146,27 +275,22 @@ class Download:
 
         # ----------------------------------------------------------------------
 
-        custom_opts = json.loads(os.environ.get("YDL_OPTS", "{}"))
-        assert isinstance(custom_opts, dict)
-
-        opts = copy.copy(YDL_OPTIONS)
-        opts.update(
-            logger=self.logger,
-            outtmpl=os.path.join(self.output_dir, "%(id)s/%(id)s.%(ext)s"),
+        extra = {}
+        if args.log:
+            ffmpeg_log = str(args.log).replace(".log", "-ffmpeg.log")
+            extra["postprocessor_args"] = ["-progress", "file:{}".format(ffmpeg_log)]
+
+        self.opts = ydl_options(
+            self.logger,
+            args.options,
+            outtmpl=os.path.join(
+                self.output_dir, "%(id)s", self.name.replace("%", "%%") + ".%(ext)s"
+            ),
             progress_hooks=[create_progress_hook(self.logger)],
             cachedir=cache_dir,
+            **extra
         )
 
-        if args.log:
-            ffmpeg_log = str(args.log).replace(".log", "-ffmpeg.log")
-            opts["postprocessor_args"] = ["-progress", "file:{}".format(ffmpeg_log)]
-
-        if custom_opts:
-            self.logger.info("Custom youtube-dl options: %s", custom_opts)
-            opts.update(custom_opts)
-
-        self.opts = opts
-
     def execute(self) -> typing.Any:
         import youtube_dl
 
@@ -185,9 +309,7 @@ class Download:
             with mock.patch.object(ydl, "process_info", process_hook):
                 ydl.download([self.url])
         except youtube_dl.DownloadError as exc:
-            if exc.exc_info[0] in SYSTEM_EXCS:
-                raise Error(str(exc), reason="system") from exc
-            raise
+            raise Error(str(exc), reason=classify(exc)) from exc
 
         if not infos:
             raise Error("result is empty")
@@ -199,12 +321,15 @@ class Download:
             if not os.path.exists(result_dir):
                 raise Error("result directory is not found: %s".format(info["id"]))
 
-            shutil.rmtree(self.dest_dir, ignore_errors=True)
-            shutil.move(result_dir, self.dest_dir)
-
             files = []
 
-            for path in glob.glob(os.path.join(self.dest_dir, "**"), recursive=True):
+            for src in glob.glob(os.path.join(result_dir, "**"), recursive=True):
+                if not os.path.isfile(src):
+                    continue
+                path = os.path.join(self.dest_dir, os.path.relpath(src, result_dir))
+                os.makedirs(os.path.dirname(path), exist_ok=True)
+                shutil.move(src, path)
+
                 self.logger.info("output file: %s", path)
                 try:
                     fi = os.stat(path)
@@ -220,6 +345,7 @@ class Download:
                         }
                     )
 
+            shutil.rmtree(result_dir, ignore_errors=True)
             result.append({"id": info["id"], "files": files})
 
         return result
@@ -228,17 +354,26 @@ class Download:
 def main():
     parser = argparse.ArgumentParser()
     parser.add_argument("--log")
-    parser.add_argument("--root", required=True)
-    parser.add_argument("--dst", required=True)
+    parser.add_argument("--root")
+    parser.add_argument("--dst")
+    parser.add_argument("--name", help="name of output files without extension")
     parser.add_argument("--cache")
+    parser.add_argument("--options", help="youtube-dl options of the download profile as JSON")
+    parser.add_argument("--estimate", action="store_true", help="only estimate the download size")
     parser.add_argument("url")
 
     args = parser.parse_args()
+    if not args.estimate and not (args.root and args.dst and args.name):
+        parser.error("--root, --dst and --name are required")
+
     logger = get_logger(args.log)
 
     try:
         with suppress_output():
-            result = Download(args).execute()
+            if args.estimate:
+                result = Estimate(args).execute()
+            else:
+                result = Download(args).execute()
         json_dump(result, sys.stdout)
 
     except Exception as exc:
@@ -248,7 +383,7 @@ def main():
         else:
             logger.exception("unknown error")
             msg = "{}: {}".format(exc.__class__.__name__, str(exc))
-            reason = "unknown"
+            reason = classify(exc)
 
         json_dump({"error": msg, "reason": reason}, sys.stderr)
         sys.exit(0xE7)
@@ -0,0 +1,250 @@
+"use strict";
+
+const state = {
+  videos: [],
+  channels: new Map(),
+  channel: "",
+};
+
+const mediaExts = [".mkv", ".webm", ".mp4"];
+const audioExts = [".m4a", ".opus", ".ogg", ".mp3", ".aac", ".flac"];
+
+const $ = (id) => document.getElementById(id);
+
+function el(tag, attrs, ...children) {
+  const e = document.createElement(tag);
+  for (const [k, v] of Object.entries(attrs || {})) {
+    e.setAttribute(k, v);
+  }
+  for (const c of children) {
+    e.append(c);
+  }
+  return e;
+}
+
+async function fetchJSON(url) {
+  const r = await fetch(url);
+  if (!r.ok) {
+    throw new Error(url + ": " + r.status);
+  }
+  return r.json();
+}
+
+async function loadVideos() {
+  const videos = [];
+  let cursor = "";
+  for (;;) {
+    const params = new URLSearchParams({status: "DONE", limit: "1000", cursor});
+    const page = await fetchJSON("/api/videos?" + params);
+    videos.push(...page.videos);
+    if (!page.next) {
+      break;
+    }
+    cursor = page.next;
+  }
+  return videos;
+}
+
+function meta(v) {
+  return v.meta || {};
+}
+
+function fileURL(v, path) {
+  return "/api/videos/" + encodeURIComponent(v.id) + "/files/" + path.split("/").map(encodeURIComponent).join("/");
+}
+
+function hasExt(path, exts) {
+  const p = path.toLowerCase();
+  return exts.some((ext) => p.endsWith(ext));
+}
+
+function renderChannels() {
+  const nav = $("channels");
+  nav.innerHTML = "";
+
+  const all = el("a", {href: "#/"}, "All channels", el("span", {}, String(state.videos.length)));
+  if (!state.channel) {
+    all.classList.add("active");
+  }
+  nav.append(all);
+
+  const channels = [...state.channels.values()].sort((a, b) => a.title.localeCompare(b.title));
+  for (const ch of channels) {
+    const a = el("a", {href: "#/channel/" + encodeURIComponent(ch.id)}, ch.title || ch.id, el("span", {}, String(ch.count)));
+    if (state.channel === ch.id) {
+      a.classList.add("active");
+    }
+    nav.append(a);
+  }
+}
+
+function renderList() {
+  const query = $("search").value.trim().toLowerCase();
+  const year = $("year").value;
+  const sort = $("sort").value;
+
+  let videos = state.videos.filter((v) => {
+    const m = meta(v);
+    if (state.channel && m.channel_id !== state.channel) {
+      return false;
+    }
+    if (year && !(m.published_at || "").startsWith(year)) {
+      return false;
+    }
+    return !query || (m.title || "").toLowerCase().includes(query);
+  });
+
+  videos.sort((a, b) => {
+    const ma = meta(a), mb = meta(b);
+    switch (sort) {
+      case "date-asc":
+        return (ma.published_at || "").localeCompare(mb.published_at || "");
+      case "title":
+        return (ma.title || "").localeCompare(mb.title || "");
+      default:
+        return (mb.published_at || "").localeCompare(ma.published_at || "");
+    }
+  });
+
+  const content = $("content");
+  content.innerHTML = "";
+
+  if (videos.length === 0) {
+    content.append(el("p", {class: "status"}, "No videos"));
+    return;
+  }
+
+  const tbody = el("tbody");
+  for (const v of videos) {
+    const m = meta(v);
+    tbody.append(el("tr", {},
+      el("td", {class: "date"}, (m.published_at || "").slice(0, 10)),
+      el("td", {}, el("a", {href: "#/channel/" + encodeURIComponent(m.channel_id || "")}, m.channel_title || "")),
+      el("td", {}, el("a", {href: "#/video/" + encodeURIComponent(v.id)}, m.title || v.id)),
+    ));
+  }
+  content.append(el("table", {}, tbody));
+}
+
+async function renderVideo(id) {
+  const content = $("content");
+  content.innerHTML = "";
+  content.append(el("p", {class: "status"}, "Loading…"));
+
+  let v;
+  try {
+    v = await fetchJSON("/api/videos/" + encodeURIComponent(id));
+  } catch (e) {
+    content.innerHTML = "";
+    content.append(el("p", {class: "status"}, "Video not found"));
+    return;
+  }
+
+  const m = meta(v);
+  const files = v.file || [];
+  content.innerHTML = "";
+
+  const media = files.filter((f) => hasExt(f.path, mediaExts)).sort((a, b) => b.size - a.size)[0];
+  const audio = files.filter((f) => hasExt(f.path, audioExts)).sort((a, b) => b.size - a.size)[0];
+  if (!media && audio) {
+    const poster = files.filter((f) => hasExt(f.path, [".jpg"])).sort((a, b) => b.size - a.size)[0];
+    if (poster) {
+      content.append(el("img", {class: "cover", src: fileURL(v, poster.path), alt: ""}));
+    }
+    content.append(el("audio", {controls: "", preload: "metadata", src: fileURL(v, audio.path)}));
+  }
+  if (media) {
+    const video = el("video", {controls: "", preload: "metadata", src: fileURL(v, media.path)});
+    for (const f of files.filter((f) => hasExt(f.path, [".vtt"]))) {
+      const lang = f.path.split(".").slice(-2, -1)[0] || "";
+      video.append(el("track", {kind: "subtitles", src: fileURL(v, f.path), srclang: lang, label: lang}));
+    }
+    content.append(video);
+  }
+
+  content.append(el("h2", {}, m.title || v.id));
+  content.append(el("p", {class: "meta"},
+    el("a", {href: "#/channel/" + encodeURIComponent(m.channel_id || "")}, m.channel_title || ""),
+    " · " + (m.published_at || "").slice(0, 10) + " · ",
+    el("a", {href: "https://www.youtube.com/watch?v=" + encodeURIComponent(v.id), target: "_blank", rel: "noopener"}, "YouTube"),
+  ));
+
+  if (m.tags && m.tags.length) {
+    content.append(el("p", {class: "tags"}, ...m.tags.map((t) => el("span", {}, t))));
+  }
+  if (m.description) {
+    content.append(el("div", {class: "description"}, m.description));
+  }
+
+  const info = files.find((f) => f.path.endsWith(".info.json"));
+  if (info) {
+    try {
+      const data = await fetchJSON(fileURL(v, info.path));
+      const rows = [
+        ["Duration", data.duration ? new Date(data.duration * 1000).toISOString().slice(11, 19) : ""],
+        ["Views", data.view_count],
+        ["Likes", data.like_count],
+        ["Uploader", data.uploader],
+        ["Resolution", data.width && data.height ? data.width + "×" + data.height : ""],
+        ["Format", data.format],
+      ].filter((r) => r[1] !== undefined && r[1] !== null && r[1] !== "");
+      content.append(el("h3", {}, "Details"));
+      content.append(el("table", {}, el("tbody", {}, ...rows.map((r) => el("tr", {}, el("th", {}, r[0]), el("td", {}, String(r[1])))))));
+    } catch (e) {
+      console.warn(e);
+    }
+  }
+
+  content.append(el("h3", {}, "Files"));
+  content.append(el("ul", {}, ...files.map((f) => el("li", {}, el("a", {href: fileURL(v, f.path)}, f.path.split("/").pop())))));
+}
+
+function route() {
+  const hash = decodeURIComponent(location.hash.slice(1));
+  if (hash.startsWith("/video/")) {
+    renderVideo(hash.slice("/video/".length));
+    return;
+  }
+  state.channel = hash.startsWith("/channel/") ? hash.slice("/channel/".length) : "";
+  renderChannels();
+  renderList();
+}
+
+async function main() {
+  try {
+    state.videos = await loadVideos();
+  } catch (e) {
+    $("content").innerHTML = "";
+    $("content").append(el("p", {class: "status"}, "Could not load videos: " + e.message));
+    return;
+  }
+
+  const years = new Set();
+  for (const v of state.videos) {
+    const m = meta(v);
+    const ch = state.channels.get(m.channel_id) || {id: m.channel_id, title: m.channel_title, count: 0};
+    ch.count++;
+    state.channels.set(m.channel_id, ch);
+    if (m.published_at) {
+      years.add(m.published_at.slice(0, 4));
+    }
+  }
+  for (const y of [...years].sort().reverse()) {
+    $("year").append(el("option", {value: y}, y));
+  }
+
+  for (const id of ["search", "year", "sort"]) {
+    $(id).addEventListener("input", () => {
+      if (location.hash.startsWith("#/video/")) {
+        location.hash = state.channel ? "#/channel/" + encodeURIComponent(state.channel) : "#/";
+      } else {
+        renderList();
+      }
+    });
+  }
+
+  window.addEventListener("hashchange", route);
+  route();
+}
+
+main();